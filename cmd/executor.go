@@ -7,9 +7,11 @@ import (
 
 	"github.com/forbole/njuno/types/config"
 
+	exportcmd "github.com/forbole/njuno/cmd/export"
 	initcmd "github.com/forbole/njuno/cmd/init"
 	parsecmd "github.com/forbole/njuno/cmd/parse"
 	startcmd "github.com/forbole/njuno/cmd/start"
+	verifycmd "github.com/forbole/njuno/cmd/verify"
 
 	"github.com/forbole/njuno/types"
 
@@ -43,6 +45,8 @@ func BuildDefaultExecutor(config *Config) cli.Executor {
 		initcmd.NewInitCmd(config.GetInitConfig()),
 		parsecmd.NewParseCmd(config.GetParseConfig()),
 		startcmd.NewStartCmd(config.GetParseConfig()),
+		exportcmd.NewExportCmd(config.GetParseConfig()),
+		verifycmd.NewVerifyCmd(config.GetParseConfig()),
 	)
 
 	return PrepareRootCmd(config.GetName(), rootCmd)
@@ -0,0 +1,325 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/types/config"
+)
+
+const (
+	flagFormat      = "format"
+	flagOutput      = "output"
+	flagCompression = "compression"
+	flagChunkSize   = "chunk-size"
+	flagBatchSize   = "batch-size"
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagStartDate   = "start-date"
+	flagEndDate     = "end-date"
+	flagResume      = "resume"
+
+	dateLayout = "2006-01-02"
+)
+
+// NewExportCmd returns the Cobra command that allows to export the indexed data into files
+func NewExportCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       fmt.Sprintf("export [%s]", strings.Join(entitiesNames(), "|")),
+		Short:     "Export the indexed data of the given entity into CSV, JSON Lines or Parquet files",
+		ValidArgs: entitiesNames(),
+		Args:      cobra.ExactValidArgs(1),
+		Long: fmt.Sprintf(`Stream all the stored data of the given entity into one or more files inside the output directory.
+You can specify a custom heights range by using the %[1]s and %[2]s flags, or a dates range (using the %[3]s format)
+by using the %[4]s and %[5]s flags. 
+A new file is created every time the number of written rows reaches the %[6]s value. 
+After each file is completed, the export progress is saved inside a cursor file so that an interrupted export 
+can later be continued using the %[7]s flag.
+`, flagStartHeight, flagEndHeight, dateLayout, flagStartDate, flagEndDate, flagChunkSize, flagResume),
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entityName := args[0]
+			e, ok := entities[entityName]
+			if !ok {
+				return fmt.Errorf("invalid entity: %s", entityName)
+			}
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			outputDir, _ := cmd.Flags().GetString(flagOutput)
+			compression, _ := cmd.Flags().GetString(flagCompression)
+			chunkSize, _ := cmd.Flags().GetInt(flagChunkSize)
+			batchSize, _ := cmd.Flags().GetInt64(flagBatchSize)
+			resume, _ := cmd.Flags().GetBool(flagResume)
+
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid %s: it must be greater than 0", flagBatchSize)
+			}
+
+			db, err := buildExportDb(parseConfig)
+			if err != nil {
+				return err
+			}
+
+			startHeight, endHeight, err := getHeightRange(cmd, db)
+			if err != nil {
+				return err
+			}
+
+			err = os.MkdirAll(outputDir, os.ModePerm)
+			if err != nil {
+				return fmt.Errorf("error while creating output directory: %s", err)
+			}
+
+			exporter := &exporter{
+				db:          db,
+				entityName:  entityName,
+				entity:      e,
+				outputDir:   outputDir,
+				format:      format,
+				compression: compression,
+				chunkSize:   chunkSize,
+				batchSize:   batchSize,
+				cursor: &cursor{
+					Entity: entityName, Format: format, Compression: compression, LastHeight: startHeight - 1,
+				},
+			}
+
+			if resume {
+				c, err := readCursor(cursorPath(outputDir, entityName))
+				if err != nil {
+					return err
+				}
+
+				if c != nil {
+					err = c.checkCompatible(format, compression)
+					if err != nil {
+						return err
+					}
+					log.Info().Str("entity", entityName).Int64("last height", c.LastHeight).
+						Msg("resuming export from cursor")
+					exporter.cursor = c
+				}
+			}
+
+			return exporter.export(endHeight)
+		},
+	}
+
+	cmd.Flags().String(flagFormat, FormatCSV, fmt.Sprintf("Format of the output files (%s | %s | %s)", FormatCSV, FormatJSONL, FormatParquet))
+	cmd.Flags().String(flagOutput, "export", "Directory inside which the output files will be written")
+	cmd.Flags().String(flagCompression, CompressionNone, fmt.Sprintf("Compression of the output files (%s | %s)", CompressionNone, CompressionGzip))
+	cmd.Flags().Int(flagChunkSize, 1000000, "Number of rows after which a new output file is created. If 0, a single file will be written")
+	cmd.Flags().Int64(flagBatchSize, 1000, "Number of heights read from the database with each query")
+	cmd.Flags().Int64(flagStartHeight, 0, "Height from which to start exporting. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagEndHeight, 0, "Height at which to finish exporting. If 0, the latest height stored inside the database will be used instead")
+	cmd.Flags().String(flagStartDate, "", "Date (YYYY-MM-DD) from which to start exporting. Overrides the start height if set")
+	cmd.Flags().String(flagEndDate, "", "Date (YYYY-MM-DD) at which to finish exporting (included). Overrides the end height if set")
+	cmd.Flags().Bool(flagResume, false, "Whether or not to resume the export from the cursor stored inside the output directory")
+
+	return cmd
+}
+
+// buildExportDb builds the database using the given parse configuration, making sure it supports exporting data
+func buildExportDb(parseConfig *parsecmdtypes.Config) (exportDb, error) {
+	encodingConfig := parseConfig.GetEncodingConfigBuilder()()
+	databaseCtx := database.NewContext(config.Cfg.Database, &encodingConfig, parseConfig.GetLogger())
+	db, err := parseConfig.GetDBBuilder()(databaseCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	expDb, ok := db.(exportDb)
+	if !ok {
+		return nil, fmt.Errorf("your database does not implement ExportDb")
+	}
+
+	return expDb, nil
+}
+
+// exportDb represents a database that can be read from while exporting data
+type exportDb interface {
+	database.Database
+	database.ExportDb
+}
+
+// getHeightRange returns the range of heights that should be exported based on the given command flags
+func getHeightRange(cmd *cobra.Command, db exportDb) (int64, int64, error) {
+	start, _ := cmd.Flags().GetInt64(flagStartHeight)
+	end, _ := cmd.Flags().GetInt64(flagEndHeight)
+	startDate, _ := cmd.Flags().GetString(flagStartDate)
+	endDate, _ := cmd.Flags().GetString(flagEndDate)
+
+	// Get the start height, default to the config's height; use flagStartHeight if set
+	startHeight := config.Cfg.Parser.StartHeight
+	if start > 0 {
+		startHeight = start
+	}
+
+	// Get the end height, default to the latest stored height; use flagEndHeight if set
+	endHeight, err := db.GetLastBlockHeight()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error while getting latest stored block height: %s", err)
+	}
+	if end > 0 {
+		endHeight = end
+	}
+
+	// Override the heights with the dates, if set
+	if startDate != "" || endDate != "" {
+		from := time.Unix(0, 0)
+		if startDate != "" {
+			from, err = time.Parse(dateLayout, startDate)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid start date: %s", err)
+			}
+		}
+
+		to := time.Now()
+		if endDate != "" {
+			to, err = time.Parse(dateLayout, endDate)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid end date: %s", err)
+			}
+			to = to.Add(24*time.Hour - time.Nanosecond)
+		}
+
+		minHeight, maxHeight, err := db.GetBlockHeightsInTimeRange(from, to)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if startDate != "" {
+			startHeight = minHeight
+		}
+		if endDate != "" {
+			endHeight = maxHeight
+		}
+	}
+
+	if startHeight > endHeight {
+		return 0, 0, fmt.Errorf("start height %d is greater than end height %d", startHeight, endHeight)
+	}
+
+	return startHeight, endHeight, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// exporter contains all the data needed to export a single entity
+type exporter struct {
+	db          exportDb
+	entityName  string
+	entity      entity
+	outputDir   string
+	format      string
+	compression string
+	chunkSize   int
+	batchSize   int64
+	cursor      *cursor
+
+	writer      recordWriter
+	writtenRows int
+}
+
+// export writes all the records of the exporter entity up to the given height (included)
+func (e *exporter) export(endHeight int64) error {
+	start := time.Now()
+	startHeight := e.cursor.LastHeight + 1
+
+	log.Info().Str("entity", e.entityName).Int64("start height", startHeight).Int64("end height", endHeight).
+		Msg("exporting data")
+
+	for from := startHeight; from <= endHeight; from += e.batchSize {
+		to := from + e.batchSize - 1
+		if to > endHeight {
+			to = endHeight
+		}
+
+		records, err := e.entity.fetch(e.db, from, to)
+		if err != nil {
+			return err
+		}
+
+		err = e.write(records)
+		if err != nil {
+			return err
+		}
+
+		// Complete the current file once the chunk size has been reached.
+		// This is done only at the end of a batch so that all the records of a height are inside the same file.
+		if e.chunkSize > 0 && e.writtenRows >= e.chunkSize {
+			err = e.completeChunk(to)
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Debug().Str("entity", e.entityName).Int64("height", to).
+			Float64("progress", float64(to-startHeight+1)/float64(endHeight-startHeight+1)*100).
+			Dur("elapsed", time.Since(start)).Msg("exported heights batch")
+	}
+
+	err := e.completeChunk(endHeight)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("entity", e.entityName).Dur("elapsed", time.Since(start)).Msg("export completed")
+	return nil
+}
+
+// write writes the given records inside the current chunk file, creating it if needed
+func (e *exporter) write(records []record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	if e.writer == nil {
+		path := filepath.Join(e.outputDir, fmt.Sprintf("%s_%06d.%s",
+			e.entityName, e.cursor.NextChunk, fileExtension(e.format, e.compression)))
+
+		writer, err := newRecordWriter(path, e.format, e.compression, e.entity.columns)
+		if err != nil {
+			return err
+		}
+
+		log.Info().Str("entity", e.entityName).Str("file", path).Msg("writing new file")
+		e.writer = writer
+	}
+
+	for _, r := range records {
+		err := e.writer.Write(r)
+		if err != nil {
+			return fmt.Errorf("error while writing %s record: %s", e.entityName, err)
+		}
+	}
+
+	e.writtenRows += len(records)
+	return nil
+}
+
+// completeChunk closes the current chunk file, if any, and stores the cursor
+// telling that all the heights up to the given one have been exported
+func (e *exporter) completeChunk(height int64) error {
+	if e.writer != nil {
+		err := e.writer.Close()
+		if err != nil {
+			return fmt.Errorf("error while closing %s file: %s", e.entityName, err)
+		}
+
+		e.writer = nil
+		e.writtenRows = 0
+		e.cursor.NextChunk++
+	}
+
+	e.cursor.LastHeight = height
+	return writeCursor(cursorPath(e.outputDir, e.entityName), e.cursor)
+}
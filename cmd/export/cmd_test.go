@@ -0,0 +1,101 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/database"
+)
+
+// newTestExporter returns an exporter of an entity having two records for each height
+func newTestExporter(t *testing.T, dir string, chunkSize int, c *cursor) *exporter {
+	return &exporter{
+		entityName: "block",
+		entity: entity{
+			columns: testColumns,
+			fetch: func(_ database.ExportDb, from, to int64) ([]record, error) {
+				var records []record
+				for height := from; height <= to; height++ {
+					records = append(records, record{height, "A"}, record{height, "B"})
+				}
+				return records, nil
+			},
+		},
+		outputDir:   dir,
+		format:      FormatCSV,
+		compression: CompressionNone,
+		chunkSize:   chunkSize,
+		batchSize:   2,
+		cursor:      c,
+	}
+}
+
+// readTestChunk returns the heights written inside the chunk file having the given index
+func readTestChunk(t *testing.T, dir string, index int) []string {
+	file, err := os.Open(filepath.Join(dir, fmt.Sprintf("block_%06d.csv", index)))
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+
+	var heights []string
+	for _, row := range rows[1:] {
+		heights = append(heights, row[0])
+	}
+	return heights
+}
+
+func TestExporter_Chunks(t *testing.T) {
+	dir := t.TempDir()
+
+	// Each batch contains 2 heights (4 rows), so each chunk is completed after every batch
+	exp := newTestExporter(t, dir, 3, &cursor{Entity: "block", Format: FormatCSV, LastHeight: 0})
+	require.NoError(t, exp.export(5))
+
+	require.Equal(t, []string{"1", "1", "2", "2"}, readTestChunk(t, dir, 0))
+	require.Equal(t, []string{"3", "3", "4", "4"}, readTestChunk(t, dir, 1))
+	require.Equal(t, []string{"5", "5"}, readTestChunk(t, dir, 2))
+
+	c, err := readCursor(cursorPath(dir, "block"))
+	require.NoError(t, err)
+	require.Equal(t, &cursor{Entity: "block", Format: FormatCSV, LastHeight: 5, NextChunk: 3}, c)
+}
+
+func TestExporter_SingleFile(t *testing.T) {
+	dir := t.TempDir()
+
+	exp := newTestExporter(t, dir, 0, &cursor{Entity: "block", Format: FormatCSV, LastHeight: 0})
+	require.NoError(t, exp.export(3))
+
+	require.Equal(t, []string{"1", "1", "2", "2", "3", "3"}, readTestChunk(t, dir, 0))
+	_, err := os.Stat(filepath.Join(dir, "block_000001.csv"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExporter_Resume(t *testing.T) {
+	dir := t.TempDir()
+
+	// Export the first heights, then resume from the stored cursor
+	exp := newTestExporter(t, dir, 3, &cursor{Entity: "block", Format: FormatCSV, LastHeight: 0})
+	require.NoError(t, exp.export(2))
+
+	c, err := readCursor(cursorPath(dir, "block"))
+	require.NoError(t, err)
+
+	exp = newTestExporter(t, dir, 3, c)
+	require.NoError(t, exp.export(4))
+
+	require.Equal(t, []string{"1", "1", "2", "2"}, readTestChunk(t, dir, 0))
+	require.Equal(t, []string{"3", "3", "4", "4"}, readTestChunk(t, dir, 1))
+
+	c, err = readCursor(cursorPath(dir, "block"))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), c.LastHeight)
+	require.Equal(t, 2, c.NextChunk)
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// cursor contains the progress of an export, and allows to resume it later
+type cursor struct {
+	Entity      string `json:"entity"`
+	Format      string `json:"format"`
+	Compression string `json:"compression"`
+	LastHeight  int64  `json:"last_height"`
+	NextChunk   int    `json:"next_chunk"`
+}
+
+// checkCompatible returns an error if the export described by the cursor can not be resumed
+// using the given format and compression, since the new chunks would not match the existing ones
+func (c *cursor) checkCompatible(format, compression string) error {
+	if c.Format != format {
+		return fmt.Errorf("cannot resume a %s export using the %s format", c.Format, format)
+	}
+	if c.Compression != compression {
+		return fmt.Errorf("cannot resume an export compressed using %s with the %s compression", c.Compression, compression)
+	}
+	return nil
+}
+
+// cursorPath returns the path of the cursor file for the given entity inside the given output directory
+func cursorPath(outputDir, entityName string) string {
+	return filepath.Join(outputDir, fmt.Sprintf("%s.cursor.json", entityName))
+}
+
+// readCursor reads the cursor stored at the given path.
+// If no cursor is stored, nil is returned instead.
+func readCursor(path string) (*cursor, error) {
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while reading cursor file: %s", err)
+	}
+
+	var c cursor
+	err = json.Unmarshal(bz, &c)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling cursor file: %s", err)
+	}
+
+	return &c, nil
+}
+
+// writeCursor stores the given cursor at the given path
+func writeCursor(path string, c *cursor) error {
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error while marshaling cursor: %s", err)
+	}
+
+	// Write to a temporary file first so that the cursor is never left half-written
+	tmpPath := path + ".tmp"
+	err = ioutil.WriteFile(tmpPath, bz, 0600)
+	if err != nil {
+		return fmt.Errorf("error while writing cursor file: %s", err)
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,43 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_ReadWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := cursorPath(dir, "block")
+	require.Equal(t, filepath.Join(dir, "block.cursor.json"), path)
+
+	// Missing cursor
+	c, err := readCursor(path)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	expected := &cursor{Entity: "block", Format: FormatCSV, LastHeight: 100, NextChunk: 2}
+	require.NoError(t, writeCursor(path, expected))
+
+	c, err = readCursor(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, c)
+
+	// The temporary file must not be left behind
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	// Invalid cursor
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = readCursor(path)
+	require.Error(t, err)
+}
+
+func TestCursor_CheckCompatible(t *testing.T) {
+	c := &cursor{Entity: "block", Format: FormatCSV, Compression: CompressionGzip, LastHeight: 100}
+	require.NoError(t, c.checkCompatible(FormatCSV, CompressionGzip))
+	require.Error(t, c.checkCompatible(FormatJSONL, CompressionGzip))
+	require.Error(t, c.checkCompatible(FormatCSV, CompressionNone))
+}
@@ -0,0 +1,142 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forbole/njuno/database"
+	dbtypes "github.com/forbole/njuno/database/types"
+)
+
+// columnType represents the type of the values contained inside a single exported column
+type columnType int
+
+const (
+	columnTypeInt64 columnType = iota
+	columnTypeString
+)
+
+// column contains the details of a single exported column
+type column struct {
+	name       string
+	columnType columnType
+}
+
+// record represents a single exported row, containing one value for each of the entity columns
+type record []interface{}
+
+// entity represents a single kind of data that can be exported
+type entity struct {
+	columns []column
+
+	// fetch returns all the records of this entity having a height between from and to (both included)
+	fetch func(db database.ExportDb, from, to int64) ([]record, error)
+}
+
+// entities contains all the supported entities, indexed by their names
+var entities = map[string]entity{
+	"block": {
+		columns: []column{
+			{"height", columnTypeInt64},
+			{"hash", columnTypeString},
+			{"num_txs", columnTypeInt64},
+			{"total_gas", columnTypeInt64},
+			{"proposer_address", columnTypeString},
+			{"timestamp", columnTypeString},
+		},
+		fetch: func(db database.ExportDb, from, to int64) ([]record, error) {
+			rows, err := db.GetBlocksInRange(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting blocks: %s", err)
+			}
+
+			records := make([]record, len(rows))
+			for i, row := range rows {
+				records[i] = record{
+					row.Height, row.Hash, row.TxNum, row.TotalGas,
+					dbtypes.ToString(row.ProposerAddress), formatTime(row.Timestamp),
+				}
+			}
+			return records, nil
+		},
+	},
+	"transaction": {
+		columns: []column{
+			{"hash", columnTypeString},
+			{"height", columnTypeInt64},
+			{"memo", columnTypeString},
+			{"signatures", columnTypeString},
+			{"signers", columnTypeString},
+			{"fee", columnTypeString},
+			{"gas", columnTypeString},
+		},
+		fetch: func(db database.ExportDb, from, to int64) ([]record, error) {
+			rows, err := db.GetTransactionsInRange(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting transactions: %s", err)
+			}
+
+			records := make([]record, len(rows))
+			for i, row := range rows {
+				records[i] = record{
+					row.Hash, row.Height, dbtypes.ToString(row.Memo), strings.Join(row.Signatures, ","),
+					strings.Join(row.Signers, ","), row.Fee, dbtypes.ToString(row.Gas),
+				}
+			}
+			return records, nil
+		},
+	},
+	"pre_commit": {
+		columns: []column{
+			{"validator_address", columnTypeString},
+			{"height", columnTypeInt64},
+			{"timestamp", columnTypeString},
+			{"voting_power", columnTypeInt64},
+			{"proposer_priority", columnTypeInt64},
+		},
+		fetch: func(db database.ExportDb, from, to int64) ([]record, error) {
+			rows, err := db.GetPreCommitsInRange(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting pre commits: %s", err)
+			}
+
+			records := make([]record, len(rows))
+			for i, row := range rows {
+				records[i] = record{
+					row.ValidatorAddress, row.Height, formatTime(row.Timestamp), row.VotingPower, row.ProposerPriority,
+				}
+			}
+			return records, nil
+		},
+	},
+	"validator": {
+		columns: []column{
+			{"consensus_address", columnTypeString},
+			{"self_delegate_address", columnTypeString},
+			{"height", columnTypeInt64},
+		},
+		fetch: func(db database.ExportDb, from, to int64) ([]record, error) {
+			rows, err := db.GetValidatorsInRange(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting validators: %s", err)
+			}
+
+			records := make([]record, len(rows))
+			for i, row := range rows {
+				records[i] = record{row.ConsAddress, row.SelfDelegateAddress, row.Height}
+			}
+			return records, nil
+		},
+	},
+}
+
+// entitiesNames returns the sorted names of all the supported entities
+func entitiesNames() []string {
+	return []string{"block", "pre_commit", "transaction", "validator"}
+}
+
+// formatTime returns the string representation of the given time that is used inside the exported files
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,288 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	FormatCSV     = "csv"
+	FormatJSONL   = "jsonl"
+	FormatParquet = "parquet"
+
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+)
+
+// recordWriter represents a writer that allows to write the records of an entity inside a single file
+type recordWriter interface {
+	// Write writes the given record
+	Write(r record) error
+
+	// Close flushes all the pending data and closes the underlying file
+	Close() error
+}
+
+// fileExtension returns the extension of the files having the given format and compression
+func fileExtension(format, compression string) string {
+	if compression == CompressionGzip && format != FormatParquet {
+		return fmt.Sprintf("%s.gz", format)
+	}
+	return format
+}
+
+// newRecordWriter returns a new recordWriter that writes the records having the given columns
+// inside the file at the given path, using the given format and compression
+func newRecordWriter(path, format, compression string, columns []column) (recordWriter, error) {
+	if compression != CompressionNone && compression != CompressionGzip {
+		return nil, fmt.Errorf("invalid compression: %s", compression)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating file %s: %s", path, err)
+	}
+
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(file, compression, columns)
+	case FormatJSONL:
+		return newJSONLWriter(file, compression, columns), nil
+	case FormatParquet:
+		return newParquetWriter(file, compression, columns)
+	default:
+		file.Close()
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// compressedFile wraps a file, optionally compressing all the data that is written inside it
+type compressedFile struct {
+	file *os.File
+	gzip *gzip.Writer
+	buf  *bufio.Writer
+}
+
+func newCompressedFile(file *os.File, compression string) *compressedFile {
+	f := &compressedFile{file: file}
+	if compression == CompressionGzip {
+		f.gzip = gzip.NewWriter(file)
+		f.buf = bufio.NewWriter(f.gzip)
+	} else {
+		f.buf = bufio.NewWriter(file)
+	}
+	return f
+}
+
+// Write implements io.Writer
+func (f *compressedFile) Write(p []byte) (int, error) {
+	return f.buf.Write(p)
+}
+
+// Close flushes all the data and closes the file
+func (f *compressedFile) Close() error {
+	err := f.buf.Flush()
+	if err != nil {
+		return err
+	}
+
+	if f.gzip != nil {
+		err = f.gzip.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return f.file.Close()
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ recordWriter = &csvWriter{}
+
+// csvWriter represents a recordWriter that writes the records using the CSV format
+type csvWriter struct {
+	file   *compressedFile
+	writer *csv.Writer
+}
+
+func newCSVWriter(file *os.File, compression string, columns []column) (*csvWriter, error) {
+	f := newCompressedFile(file, compression)
+	w := &csvWriter{file: f, writer: csv.NewWriter(f)}
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+
+	err := w.writer.Write(header)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("error while writing csv header: %s", err)
+	}
+
+	return w, nil
+}
+
+// Write implements recordWriter
+func (w *csvWriter) Write(r record) error {
+	values := make([]string, len(r))
+	for i, value := range r {
+		values[i] = fmt.Sprint(value)
+	}
+	return w.writer.Write(values)
+}
+
+// Close implements recordWriter
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		return err
+	}
+	return w.file.Close()
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ recordWriter = &jsonlWriter{}
+
+// jsonlWriter represents a recordWriter that writes the records using the JSON Lines format
+type jsonlWriter struct {
+	file    *compressedFile
+	columns []column
+}
+
+func newJSONLWriter(file *os.File, compression string, columns []column) *jsonlWriter {
+	return &jsonlWriter{file: newCompressedFile(file, compression), columns: columns}
+}
+
+// Write implements recordWriter
+func (w *jsonlWriter) Write(r record) error {
+	bz, err := marshalRecordJSON(w.columns, r)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.file.Write(append(bz, '\n'))
+	return err
+}
+
+// Close implements recordWriter
+func (w *jsonlWriter) Close() error {
+	return w.file.Close()
+}
+
+// marshalRecordJSON serializes the given record as a JSON object, keeping the order of the given columns
+func marshalRecordJSON(columns []column, r record) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, c := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(c.name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(r[i])
+		if err != nil {
+			return nil, fmt.Errorf("error while serializing column %s: %s", c.name, err)
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ recordWriter = &parquetWriter{}
+
+// parquetWriter represents a recordWriter that writes the records using the Parquet format
+type parquetWriter struct {
+	file    io.WriteCloser
+	writer  *writer.JSONWriter
+	columns []column
+}
+
+func newParquetWriter(file *os.File, compression string, columns []column) (*parquetWriter, error) {
+	schema, err := parquetSchema(columns)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	w, err := writer.NewJSONWriterFromWriter(schema, file, 4)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error while creating parquet writer: %s", err)
+	}
+
+	w.CompressionType = parquet.CompressionCodec_SNAPPY
+	if compression == CompressionGzip {
+		w.CompressionType = parquet.CompressionCodec_GZIP
+	}
+
+	return &parquetWriter{file: file, writer: w, columns: columns}, nil
+}
+
+// parquetSchema returns the JSON schema definition used by the parquet writer for the given columns
+func parquetSchema(columns []column) (string, error) {
+	type field struct {
+		Tag string `json:"Tag"`
+	}
+	type schema struct {
+		Tag    string  `json:"Tag"`
+		Fields []field `json:"Fields"`
+	}
+
+	s := schema{Tag: "name=parquet_go_root, repetitiontype=REQUIRED"}
+	for _, c := range columns {
+		switch c.columnType {
+		case columnTypeInt64:
+			s.Fields = append(s.Fields, field{Tag: fmt.Sprintf("name=%s, type=INT64, repetitiontype=REQUIRED", c.name)})
+		default:
+			s.Fields = append(s.Fields, field{Tag: fmt.Sprintf("name=%s, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=REQUIRED", c.name)})
+		}
+	}
+
+	bz, err := json.Marshal(&s)
+	if err != nil {
+		return "", fmt.Errorf("error while building parquet schema: %s", err)
+	}
+	return string(bz), nil
+}
+
+// Write implements recordWriter
+func (w *parquetWriter) Write(r record) error {
+	bz, err := marshalRecordJSON(w.columns, r)
+	if err != nil {
+		return err
+	}
+	return w.writer.Write(string(bz))
+}
+
+// Close implements recordWriter
+func (w *parquetWriter) Close() error {
+	err := w.writer.WriteStop()
+	if err != nil {
+		return fmt.Errorf("error while finalizing parquet file: %s", err)
+	}
+	return w.file.Close()
+}
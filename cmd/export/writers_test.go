@@ -0,0 +1,126 @@
+package export
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+var testColumns = []column{
+	{"height", columnTypeInt64},
+	{"hash", columnTypeString},
+}
+
+var testRecords = []record{
+	{int64(1), "A"},
+	{int64(2), "B,\"quoted\""},
+}
+
+// writeTestFile writes the test records inside a new file using the given format and compression
+func writeTestFile(t *testing.T, format, compression string) string {
+	path := filepath.Join(t.TempDir(), "block."+fileExtension(format, compression))
+
+	w, err := newRecordWriter(path, format, compression, testColumns)
+	require.NoError(t, err)
+	for _, r := range testRecords {
+		require.NoError(t, w.Write(r))
+	}
+	require.NoError(t, w.Close())
+
+	return path
+}
+
+// openTestFile opens the file at the given path, decompressing it if needed
+func openTestFile(t *testing.T, path, compression string) io.Reader {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { file.Close() })
+
+	if compression == CompressionGzip {
+		gz, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		return gz
+	}
+	return file
+}
+
+func TestFileExtension(t *testing.T) {
+	require.Equal(t, "csv", fileExtension(FormatCSV, CompressionNone))
+	require.Equal(t, "jsonl.gz", fileExtension(FormatJSONL, CompressionGzip))
+	require.Equal(t, "parquet", fileExtension(FormatParquet, CompressionGzip))
+}
+
+func TestNewRecordWriter_InvalidParams(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := newRecordWriter(filepath.Join(dir, "a"), FormatCSV, "zip", testColumns)
+	require.Error(t, err)
+
+	_, err = newRecordWriter(filepath.Join(dir, "b"), "xml", CompressionNone, testColumns)
+	require.Error(t, err)
+}
+
+func TestCSVWriter(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionGzip} {
+		path := writeTestFile(t, FormatCSV, compression)
+
+		rows, err := csv.NewReader(openTestFile(t, path, compression)).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"height", "hash"},
+			{"1", "A"},
+			{"2", "B,\"quoted\""},
+		}, rows)
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionGzip} {
+		path := writeTestFile(t, FormatJSONL, compression)
+
+		var lines []string
+		scanner := bufio.NewScanner(openTestFile(t, path, compression))
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		require.NoError(t, scanner.Err())
+		require.Equal(t, []string{
+			`{"height":1,"hash":"A"}`,
+			`{"height":2,"hash":"B,\"quoted\""}`,
+		}, lines)
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionGzip} {
+		path := writeTestFile(t, FormatParquet, compression)
+
+		file, err := local.NewLocalFileReader(path)
+		require.NoError(t, err)
+
+		pr, err := reader.NewParquetReader(file, nil, 1)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), pr.GetNumRows())
+
+		heights, _, _, err := pr.ReadColumnByPath("parquet_go_root\x01height", 2)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int64(1), int64(2)}, heights)
+
+		hashes, _, _, err := pr.ReadColumnByPath("parquet_go_root\x01hash", 2)
+		require.NoError(t, err)
+		require.Equal(t, "A", hashes[0])
+		require.True(t, strings.HasPrefix(hashes[1].(string), "B,"))
+
+		pr.ReadStop()
+		require.NoError(t, file.Close())
+	}
+}
@@ -0,0 +1,166 @@
+package blocks
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/parser"
+	"github.com/forbole/njuno/types"
+	"github.com/forbole/njuno/types/config"
+)
+
+const (
+	flagWorkers = "workers"
+
+	// progressInterval represents the interval after which the backfill progress is logged
+	progressInterval = 10 * time.Second
+)
+
+// newMissingCmd returns a Cobra command that allows to parse only the blocks that are missing from the database
+func newMissingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missing",
+		Short: "Find the missing blocks in database and parse only them",
+		Long: fmt.Sprintf(`Find all the ranges of heights that are missing from the database and parse the blocks they contain. 
+You can specify a custom blocks range by using the %s and %s flags, and the number of blocks parsed concurrently 
+by using the %s flag. 
+`, flagStart, flagEnd, flagWorkers),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the flag values
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+			workersCount, _ := cmd.Flags().GetInt64(flagWorkers)
+
+			if workersCount <= 0 {
+				return fmt.Errorf("invalid %s: it must be greater than 0", flagWorkers)
+			}
+
+			// Get the start height, default to the config's height; use flagStart if set
+			startHeight := config.Cfg.Parser.StartHeight
+			if start > 0 {
+				startHeight = start
+			}
+
+			// Get the end height, default to the node latest height; use flagEnd if set
+			endHeight, err := parseCtx.Node.LatestHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting chain latest block height: %s", err)
+			}
+			if end > 0 {
+				endHeight = end
+			}
+
+			gaps, err := parseCtx.Database.GetMissingBlocksRanges(startHeight, endHeight)
+			if err != nil {
+				return err
+			}
+
+			var total int64
+			for _, gap := range gaps {
+				total += gap.Len()
+			}
+
+			log.Info().Int64("start height", startHeight).Int64("end height", endHeight).
+				Int("gaps", len(gaps)).Int64("missing blocks", total).Msg("parsing missing blocks")
+
+			if total == 0 {
+				return nil
+			}
+
+			return parseMissingBlocks(parseCtx, gaps, total, workersCount)
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start getting missing blocks. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to finish getting missing. If 0, the latest height available inside the node will be used instead")
+	cmd.Flags().Int64(flagWorkers, 1, "Number of blocks to be parsed concurrently")
+
+	return cmd
+}
+
+// parseMissingBlocks parses all the heights contained inside the given gaps using workersCount concurrent workers,
+// periodically logging the progress and the estimated remaining time
+func parseMissingBlocks(ctx *parser.Context, gaps []types.HeightRange, total int64, workersCount int64) error {
+	queue := types.NewQueue(int(workersCount))
+
+	var processed, failed int64
+	var waitGroup sync.WaitGroup
+	for i := int64(0); i < workersCount; i++ {
+		worker := parser.NewWorker(ctx, queue, int(i))
+
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for height := range queue {
+				err := worker.ProcessIfNotExists(height)
+				if err != nil {
+					atomic.AddInt64(&failed, 1)
+					log.Error().Err(err).Int64("height", height).Msg("error while parsing missing block")
+				}
+				atomic.AddInt64(&processed, 1)
+			}
+		}()
+	}
+
+	// Report the progress periodically
+	startTime := time.Now()
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				logProgress(atomic.LoadInt64(&processed), total, startTime)
+			}
+		}
+	}()
+
+	for _, gap := range gaps {
+		for height := gap.Start; height <= gap.End; height++ {
+			queue <- height
+		}
+	}
+	close(queue)
+
+	waitGroup.Wait()
+	close(done)
+	logProgress(processed, total, startTime)
+
+	if failed > 0 {
+		return fmt.Errorf("error while parsing missing blocks: %d blocks failed", failed)
+	}
+
+	return nil
+}
+
+// logProgress logs the number of processed blocks, the parsing rate and the estimated remaining time
+func logProgress(processed, total int64, startTime time.Time) {
+	elapsed := time.Since(startTime)
+
+	var rate float64
+	var eta time.Duration
+	if processed > 0 {
+		rate = float64(processed) / elapsed.Seconds()
+		eta = time.Duration(float64(elapsed) / float64(processed) * float64(total-processed))
+	}
+
+	log.Info().Int64("processed", processed).Int64("total", total).
+		Str("progress", fmt.Sprintf("%.2f%%", float64(processed)/float64(total)*100)).
+		Str("rate", fmt.Sprintf("%.2f blocks/s", rate)).
+		Str("eta", eta.Round(time.Second).String()).
+		Msg("parsing missing blocks")
+}
@@ -7,7 +7,10 @@ import (
 
 	parseblocks "github.com/forbole/njuno/cmd/parse/blocks"
 	parsegenesis "github.com/forbole/njuno/cmd/parse/genesis"
+	parsepricefeed "github.com/forbole/njuno/cmd/parse/pricefeed"
+	parseproposer "github.com/forbole/njuno/cmd/parse/proposer"
 	parsestaking "github.com/forbole/njuno/cmd/parse/staking"
+	parsestats "github.com/forbole/njuno/cmd/parse/stats"
 	parsetransactions "github.com/forbole/njuno/cmd/parse/transactions"
 )
 
@@ -24,6 +27,9 @@ func NewParseCmd(parseCfg *parsecmdtypes.Config) *cobra.Command {
 		parsegenesis.NewGenesisCmd(parseCfg),
 		parsetransactions.NewTransactionsCmd(parseCfg),
 		parsestaking.NewStakingCmd(parseCfg),
+		parsestats.NewStatsCmd(parseCfg),
+		parseproposer.NewProposerCmd(parseCfg),
+		parsepricefeed.NewPricefeedCmd(parseCfg),
 	)
 
 	return cmd
@@ -0,0 +1,21 @@
+package pricefeed
+
+import (
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+)
+
+// NewPricefeedCmd returns the Cobra command that allows to fix all the things related to the pricefeed module
+func NewPricefeedCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pricefeed",
+		Short: "Fix things related to the pricefeed module",
+	}
+
+	cmd.AddCommand(
+		historyCmd(parseConfig),
+	)
+
+	return cmd
+}
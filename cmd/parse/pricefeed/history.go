@@ -0,0 +1,78 @@
+package pricefeed
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/modules/pricefeed"
+	"github.com/forbole/njuno/types/config"
+)
+
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+
+	dateLayout = "2006-01-02"
+)
+
+// historyCmd returns a Cobra command that allows to backfill the tokens prices history
+func historyCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Backfill the tokens prices history and the hourly and daily price candles",
+		Long: fmt.Sprintf(`Read the historical prices of all the tokens having a price id from the configured price providers, 
+store them inside the prices history and compute the hourly and daily price candles.
+You can specify a custom dates range (using the %s format) by using the %s and %s flags. 
+By default, the prices of the last 365 days up to today will be read.
+`, dateLayout, flagStart, flagEnd),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			start, _ := cmd.Flags().GetString(flagStart)
+			end, _ := cmd.Flags().GetString(flagEnd)
+
+			// Get the end date, default to now
+			endDate := time.Now().UTC()
+			if end != "" {
+				endDate, err = time.Parse(dateLayout, end)
+				if err != nil {
+					return fmt.Errorf("invalid end date: %s", err)
+				}
+
+				// Include the whole end date
+				endDate = endDate.Add(24*time.Hour - time.Nanosecond)
+			}
+
+			// Get the start date, default to one year before the end date
+			startDate := endDate.AddDate(-1, 0, 0)
+			if start != "" {
+				startDate, err = time.Parse(dateLayout, start)
+				if err != nil {
+					return fmt.Errorf("invalid start date: %s", err)
+				}
+			}
+
+			if !startDate.Before(endDate) {
+				return fmt.Errorf("the start date must be before the end date")
+			}
+
+			log.Info().Str("start date", startDate.Format(dateLayout)).Str("end date", endDate.Format(dateLayout)).
+				Msg("backfilling tokens prices history")
+
+			pricefeedModule := pricefeed.NewModule(config.Cfg, parseCtx.EncodingConfig.Marshaler, parseCtx.Database, parseCtx.Logger, parseCtx.Node)
+			return pricefeedModule.BackfillPricesHistory(startDate, endDate)
+		},
+	}
+
+	cmd.Flags().String(flagStart, "", "Date (YYYY-MM-DD) from which to start reading the prices. If empty, one year before the end date will be used instead")
+	cmd.Flags().String(flagEnd, "", "Date (YYYY-MM-DD) at which to finish reading the prices. If empty, today will be used instead")
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package proposer
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/modules/proposer"
+	"github.com/forbole/njuno/types/config"
+)
+
+// NewProposerCmd returns the Cobra command that allows to backfill the validators proposer statistics
+func NewProposerCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-stats",
+		Short: "Backfill the daily validators proposer statistics from the stored blocks",
+		Long: fmt.Sprintf(`Compute the daily validators proposer statistics for all the dates in the given range and store them inside the database. 
+You can specify a custom dates range (using the %s format) by using the %s and %s flags. 
+By default, all the dates from the latest stored stats (or the first stored block if none) up to today will be computed.
+`, parsecmdtypes.DateLayout, parsecmdtypes.FlagStart, parsecmdtypes.FlagEnd),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := proposer.CheckValidatorSetConfig(config.Cfg)
+			if err != nil {
+				return err
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the start date, default to the latest stored stats or to the first stored block date
+			lastDate, err := parseCtx.Database.GetLastProposerStatsDate()
+			if err != nil {
+				return fmt.Errorf("error while getting latest proposer stats date: %s", err)
+			}
+
+			startDate, endDate, err := parsecmdtypes.GetDatesRange(cmd, lastDate)
+			if err != nil {
+				return err
+			}
+
+			log.Info().Str("start date", startDate.Format(parsecmdtypes.DateLayout)).
+				Str("end date", endDate.Format(parsecmdtypes.DateLayout)).Msg("updating proposer stats")
+
+			err = proposer.NewModule(config.Cfg, parseCtx.Database).UpdateProposerStatsInRange(startDate, endDate)
+			if err != nil {
+				return fmt.Errorf("error while updating proposer stats: %s", err)
+			}
+
+			return nil
+		},
+	}
+
+	parsecmdtypes.AddDatesRangeFlags(cmd)
+
+	return cmd
+}
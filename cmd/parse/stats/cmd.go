@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/modules/stats"
+	"github.com/forbole/njuno/types"
+	"github.com/forbole/njuno/types/config"
+)
+
+// NewStatsCmd returns the Cobra command that allows to backfill the pre-aggregated chain statistics
+func NewStatsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Backfill the daily and hourly chain statistics",
+		Long: fmt.Sprintf(`Compute the daily and hourly chain statistics for all the dates in the given range and store them inside the database. 
+You can specify a custom dates range (using the %s format) by using the %s and %s flags. 
+By default, all the dates from the latest stored stats (or the first stored block if none) up to today will be computed.
+`, parsecmdtypes.DateLayout, parsecmdtypes.FlagStart, parsecmdtypes.FlagEnd),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the start date, default to the latest stored daily stats or to the first stored block date
+			lastDate, err := parseCtx.Database.GetLastChainStatsPeriod(types.StatsGranularityDay)
+			if err != nil {
+				return fmt.Errorf("error while getting latest chain stats date: %s", err)
+			}
+
+			startDate, endDate, err := parsecmdtypes.GetDatesRange(cmd, lastDate)
+			if err != nil {
+				return err
+			}
+
+			statsModule := stats.NewModule(parseCtx.Database)
+			for _, granularity := range []types.StatsGranularity{types.StatsGranularityHour, types.StatsGranularityDay} {
+				log.Info().Str("granularity", string(granularity)).
+					Str("start date", startDate.Format(parsecmdtypes.DateLayout)).
+					Str("end date", endDate.Format(parsecmdtypes.DateLayout)).
+					Msg("updating chain stats")
+
+				err = statsModule.UpdateStatsInRange(granularity, startDate, endDate)
+				if err != nil {
+					return fmt.Errorf("error while updating %s chain stats: %s", granularity, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	parsecmdtypes.AddDatesRangeFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	FlagStart = "start"
+	FlagEnd   = "end"
+
+	DateLayout = "2006-01-02"
+)
+
+// AddDatesRangeFlags adds to the given command the flags that allow to specify a custom dates range
+func AddDatesRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStart, "", "Date (YYYY-MM-DD) from which to start computing the stats. If empty, the date of the latest stored stats will be used instead")
+	cmd.Flags().String(FlagEnd, "", "Date (YYYY-MM-DD) at which to finish computing the stats. If empty, today will be used instead")
+}
+
+// GetDatesRange returns the dates range specified using the flags added by AddDatesRangeFlags.
+// When no start date is given, defaultStart is used instead. When no end date is given, today is used instead.
+// The returned end includes the whole end date.
+func GetDatesRange(cmd *cobra.Command, defaultStart time.Time) (start time.Time, end time.Time, err error) {
+	startFlag, _ := cmd.Flags().GetString(FlagStart)
+	endFlag, _ := cmd.Flags().GetString(FlagEnd)
+
+	start = defaultStart
+	if startFlag != "" {
+		start, err = time.Parse(DateLayout, startFlag)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start date: %s", err)
+		}
+	}
+
+	end = time.Now().UTC()
+	if endFlag != "" {
+		end, err = time.Parse(DateLayout, endFlag)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date: %s", err)
+		}
+	}
+
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, fmt.Errorf("no blocks stored inside the database, please specify a start date using the %s flag", FlagStart)
+	}
+
+	// Include the whole end date
+	end = types.StatsGranularityDay.Truncate(end).Add(24*time.Hour - time.Nanosecond)
+	return start, end, nil
+}
@@ -60,6 +60,14 @@ func StartParsing(ctx *parser.Context) error {
 	cfg := config.Cfg.Parser
 	logging.StartHeight.Add(float64(cfg.StartHeight))
 
+	// Create a queue that allows modules to parse again the heights that are already stored
+	reprocessQueue := types.NewQueue(25)
+	for _, module := range ctx.Modules {
+		if module, ok := module.(modules.ReprocessModule); ok {
+			module.SetReprocessQueue(reprocessQueue)
+		}
+	}
+
 	// Start periodic operations
 	scheduler := gocron.NewScheduler(time.UTC)
 	for _, module := range ctx.Modules {
@@ -97,6 +105,9 @@ func StartParsing(ctx *parser.Context) error {
 		go w.Start()
 	}
 
+	// Start the worker that parses again the heights enqueued by the modules
+	go parser.NewWorker(ctx, reprocessQueue, len(workers)).StartReprocessing()
+
 	// Listen for and trap any OS signal to gracefully shutdown and exit
 	trapSignal(ctx)
 
@@ -118,8 +129,8 @@ func StartParsing(ctx *parser.Context) error {
 	return nil
 }
 
-// enqueueMissingBlocks enqueues jobs (block heights) for missed blocks starting
-// at the startHeight up until the latest known height.
+// enqueueMissingBlocks enqueues jobs (block heights) for the blocks that are missing from the database,
+// starting at the startHeight up until the latest known height.
 func enqueueMissingBlocks(exportQueue types.HeightQueue, ctx *parser.Context) {
 	// Get the config
 	cfg := config.Cfg.Parser
@@ -145,10 +156,17 @@ func enqueueMissingBlocks(exportQueue types.HeightQueue, ctx *parser.Context) {
 			}
 		}
 	} else {
-		ctx.Logger.Info("syncing missing blocks...", "latest_block_height", latestBlockHeight)
-		for i := cfg.StartHeight; i <= latestBlockHeight; i++ {
-			ctx.Logger.Debug("enqueueing missing block", "height", i)
-			exportQueue <- i
+		gaps, err := ctx.Database.GetMissingBlocksRanges(cfg.StartHeight, latestBlockHeight)
+		if err != nil {
+			panic(fmt.Errorf("failed to get missing blocks ranges: %s", err))
+		}
+
+		ctx.Logger.Info("syncing missing blocks...", "latest_block_height", latestBlockHeight, "gaps", len(gaps))
+		for _, gap := range gaps {
+			for i := gap.Start; i <= gap.End; i++ {
+				ctx.Logger.Debug("enqueueing missing block", "height", i)
+				exportQueue <- i
+			}
 		}
 	}
 }
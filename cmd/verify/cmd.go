@@ -0,0 +1,148 @@
+package verify
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	parsecmdtypes "github.com/forbole/njuno/cmd/parse/types"
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules/verify"
+	"github.com/forbole/njuno/parser"
+	"github.com/forbole/njuno/types"
+	"github.com/forbole/njuno/types/config"
+)
+
+const (
+	flagStart        = "start"
+	flagEnd          = "end"
+	flagSample       = "sample"
+	flagCheckCommits = "check-commits"
+	flagReparse      = "reparse"
+)
+
+// NewVerifyCmd returns the Cobra command that allows to verify the stored data against the chain
+func NewVerifyCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the consistency of the stored data against the chain",
+		Long: fmt.Sprintf(`Compare the blocks hashes, the number of transactions, the transactions hashes and the commit signers 
+stored inside the database with the ones returned by the node, and store every mismatch inside the verification_issue table.
+You can specify a custom heights range by using the %s and %s flags, and verify only a random sample of the range
+by using the %s flag. 
+If the %s flag is set, all the heights having issues will be parsed again.
+`, flagStart, flagEnd, flagSample, flagReparse),
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			verificationDb, ok := parseCtx.Database.(database.VerificationDb)
+			if !ok {
+				return fmt.Errorf("your database does not implement VerificationDb")
+			}
+
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+			sample, _ := cmd.Flags().GetInt64(flagSample)
+			checkCommits, _ := cmd.Flags().GetBool(flagCheckCommits)
+			reparse, _ := cmd.Flags().GetBool(flagReparse)
+
+			// Get the start height, default to the config's height; use flagStart if set
+			startHeight := config.Cfg.Parser.StartHeight
+			if start > 0 {
+				startHeight = start
+			}
+			if startHeight < 1 {
+				startHeight = 1
+			}
+
+			// Get the end height, default to the latest stored height; use flagEnd if set
+			endHeight, err := parseCtx.Database.GetLastBlockHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest stored block height: %s", err)
+			}
+			if end > 0 {
+				endHeight = end
+			}
+
+			var heights []int64
+			if sample > 0 {
+				for i := int64(0); i < sample && endHeight >= startHeight; i++ {
+					heights = append(heights, startHeight+rand.Int63n(endHeight-startHeight+1))
+				}
+			} else {
+				for height := startHeight; height <= endHeight; height++ {
+					heights = append(heights, height)
+				}
+			}
+
+			verifyModule := verify.NewModule(config.Cfg, parseCtx.Node, parseCtx.Database)
+			worker := parser.NewWorker(parseCtx, nil, 0)
+
+			var issuesCount int
+			for _, height := range heights {
+				log.Debug().Int64("height", height).Msg("verifying height")
+
+				issues, err := verifyModule.VerifyHeight(height, checkCommits)
+				if err != nil {
+					return err
+				}
+
+				if len(issues) == 0 {
+					continue
+				}
+
+				for _, issue := range issues {
+					log.Info().Int64("height", issue.Height).Str("type", string(issue.Type)).
+						Str("expected", issue.Expected).Str("actual", issue.Actual).Msg("found verification issue")
+				}
+				issuesCount += len(issues)
+
+				if reparse {
+					err = reparseHeights(verifyModule, worker, issues)
+					if err != nil {
+						return err
+					}
+				}
+
+				err = verificationDb.SaveVerificationIssues(issues)
+				if err != nil {
+					return err
+				}
+			}
+
+			log.Info().Int("heights", len(heights)).Int("issues", issuesCount).Msg("verification completed")
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start verifying. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to finish verifying. If 0, the latest height stored inside the database will be used instead")
+	cmd.Flags().Int64(flagSample, 0, "Number of random heights of the range to be verified. If 0, all the heights will be verified")
+	cmd.Flags().Bool(flagCheckCommits, true, "Whether or not to verify the commit signers of each height")
+	cmd.Flags().Bool(flagReparse, false, "Whether or not to parse again the heights having issues")
+
+	return cmd
+}
+
+// reparseHeights parses again all the heights required to fix the given issues, marking them as re-enqueued
+func reparseHeights(verifyModule *verify.Module, worker parser.Worker, issues []types.VerificationIssue) error {
+	for _, height := range verifyModule.ReprocessHeights(issues) {
+		log.Info().Int64("height", height).Msg("parsing height again")
+		err := worker.Reprocess(height)
+		if err != nil {
+			return fmt.Errorf("error while parsing height %d again: %s", height, err)
+		}
+	}
+
+	for i := range issues {
+		issues[i].Reenqueued = true
+	}
+
+	return nil
+}
@@ -1,17 +1,21 @@
 package config
 
+import "time"
+
 type Config struct {
-	Name               string `yaml:"name"`
-	Host               string `yaml:"host"`
-	Port               int64  `yaml:"port"`
-	User               string `yaml:"user"`
-	Password           string `yaml:"password"`
-	SSLMode            string `yaml:"ssl_mode,omitempty"`
-	Schema             string `yaml:"schema,omitempty"`
-	MaxOpenConnections int    `yaml:"max_open_connections"`
-	MaxIdleConnections int    `yaml:"max_idle_connections"`
-	PartitionSize      int64  `yaml:"partition_size"`
-	PartitionBatchSize int64  `yaml:"partition_batch"`
+	Name               string        `yaml:"name"`
+	Host               string        `yaml:"host"`
+	Port               int64         `yaml:"port"`
+	User               string        `yaml:"user"`
+	Password           string        `yaml:"password"`
+	SSLMode            string        `yaml:"ssl_mode,omitempty"`
+	Schema             string        `yaml:"schema,omitempty"`
+	MaxOpenConnections int           `yaml:"max_open_connections"`
+	MaxIdleConnections int           `yaml:"max_idle_connections"`
+	PartitionSize      int64         `yaml:"partition_size"`
+	PartitionBatchSize int64         `yaml:"partition_batch"`
+	ReadReplicas       []string      `yaml:"read_replicas,omitempty"`
+	MaxReplicaLag      time.Duration `yaml:"max_replica_lag,omitempty"`
 }
 
 func NewDatabaseConfig(
@@ -19,6 +23,7 @@ func NewDatabaseConfig(
 	sslMode string, schema string,
 	maxOpenConnections int, maxIdleConnections int,
 	partitionSize int64, batchSize int64,
+	readReplicas []string, maxReplicaLag time.Duration,
 ) Config {
 	return Config{
 		Name:               name,
@@ -32,6 +37,8 @@ func NewDatabaseConfig(
 		MaxIdleConnections: maxIdleConnections,
 		PartitionSize:      partitionSize,
 		PartitionBatchSize: batchSize,
+		ReadReplicas:       readReplicas,
+		MaxReplicaLag:      maxReplicaLag,
 	}
 }
 
@@ -49,5 +56,7 @@ func DefaultDatabaseConfig() Config {
 		1,
 		100000,
 		1000,
+		nil,
+		30*time.Second,
 	)
 }
@@ -19,6 +19,11 @@ type Database interface {
 	// Close closes the connection to the database
 	Close()
 
+	// GetAccountBalance returns the balance of the given address stored at the given height, or at the closest
+	// lower height, or the latest one if the height is not positive. If no balance is found, nil is returned.
+	// An error is returned if the operation fails.
+	GetAccountBalance(address string, height int64) (*types.AccountBalance, error)
+
 	// GetBlockHeightTimeDayAgo returns block height from day ago.
 	// An error is returned if the operation fails.
 	GetBlockHeightTimeDayAgo(now time.Time) (dbtypes.BlockRow, error)
@@ -31,6 +36,16 @@ type Database interface {
 	// An error is returned if the operation fails.
 	GetBlockHeightTimeMinuteAgo(now time.Time) (dbtypes.BlockRow, error)
 
+	// GetDecentralizationMetrics returns the latest decentralization metrics stored at a height lower or equal
+	// than the given one, or the latest ones if the height is not positive. If no metrics are found, nil is returned.
+	// An error is returned if the operation fails.
+	GetDecentralizationMetrics(height int64) (*types.DecentralizationMetrics, error)
+
+	// GetDelegatorsAddresses returns the addresses of all the delegators having some delegations,
+	// unbonding delegations or redelegations stored in database.
+	// An error is returned if the operation fails.
+	GetDelegatorsAddresses() ([]string, error)
+
 	// GetGenesis returns the genesis details.
 	// An error is returned if the operation fails.
 	GetGenesis() (*types.Genesis, error)
@@ -43,10 +58,71 @@ type Database interface {
 	// An error is returned if the operation fails.
 	GetLastBlockHeight() (int64, error)
 
+	// GetLastChainStatsPeriod returns the start of the latest period for which the chain statistics
+	// having the given granularity are stored. If no statistics are stored yet, the period containing
+	// the first stored block is returned instead, or a zero time if no blocks are stored.
+	// An error is returned if the operation fails.
+	GetLastChainStatsPeriod(granularity types.StatsGranularity) (time.Time, error)
+
+	// GetLastProposerStatsDate returns the latest date for which the validators proposer statistics are stored.
+	// If no statistics are stored yet, the date of the first stored block is returned instead,
+	// or a zero time if no blocks are stored.
+	// An error is returned if the operation fails.
+	GetLastProposerStatsDate() (time.Time, error)
+
+	// GetMissingBlocksRanges returns the ranges of heights between from and to (both included)
+	// for which no block is stored inside the database.
+	// An error is returned if the operation fails.
+	GetMissingBlocksRanges(from, to int64) ([]types.HeightRange, error)
+
+	// GetValidatorsAvatars returns the validators avatars cached in database.
+	// An error is returned if the operation fails.
+	GetValidatorsAvatars() ([]types.ValidatorAvatar, error)
+
+	// GetValidatorsCommission returns the validators commission stored in database.
+	// An error is returned if the operation fails.
+	GetValidatorsCommission() ([]types.ValidatorCommission, error)
+
 	// GetValidatorsDescription returns validators description stored in database.
 	// An error is returned if the operation fails.
 	GetValidatorsDescription() ([]types.ValidatorDescription, error)
 
+	// GetValidatorsStatus returns the validators status stored in database.
+	// An error is returned if the operation fails.
+	GetValidatorsStatus() ([]types.ValidatorStatus, error)
+
+	// GetValidatorsVotingPower returns the validators voting power stored in database.
+	// An error is returned if the operation fails.
+	GetValidatorsVotingPower() ([]types.ValidatorVotingPower, error)
+
+	// GetStakingAPR returns the staking APR computed at the given height, or at the closest lower height,
+	// or the latest one if the height is not positive. If no APR is found, nil is returned.
+	// An error is returned if the operation fails.
+	GetStakingAPR(height int64) (*types.StakingAPR, error)
+
+	// GetStakingPool returns the latest staking pool stored in database, or nil if no pool is found.
+	// An error is returned if the operation fails.
+	GetStakingPool() (*types.StakingPool, error)
+
+	// GetSupply returns the latest total supply stored in database along with its height.
+	// If no supply is stored, nil and 0 are returned instead.
+	// An error is returned if the operation fails.
+	GetSupply() (sdk.Coins, int64, error)
+
+	// GetToken returns the token having a unit with the given denom or alias, or nil if no token is found.
+	// An error is returned if the operation fails.
+	GetToken(denom string) (*types.Token, error)
+
+	// GetLastTokenPriceCandlePeriod returns the start of the latest period for which the token price candles
+	// having the given granularity have been computed. If no candles are stored, the period containing the
+	// first stored price is returned instead, or the zero time if no prices are stored.
+	// An error is returned if the operation fails.
+	GetLastTokenPriceCandlePeriod(granularity types.StatsGranularity) (time.Time, error)
+
+	// GetTokenUnitsByPriceID returns the denom of all the token units having a price id, indexed by price id.
+	// An error is returned if the operation fails.
+	GetTokenUnitsByPriceID() (map[string]string, error)
+
 	// GetTokensPriceID returns token ID stored in database.
 	// An error is returned if the operation fails.
 	GetTokensPriceID() ([]string, error)
@@ -55,6 +131,14 @@ type Database interface {
 	// An error is returned if the operation fails.
 	HasBlock(height int64) (bool, error)
 
+	// HasValidatorSetBefore tells whether any validator set entry has been stored for a height lower than the given one.
+	// An error is returned if the operation fails.
+	HasValidatorSetBefore(height int64) (bool, error)
+
+	// SaveAccountBalances stores the given accounts balance in database, along with their history.
+	// An error is returned if the operation fails.
+	SaveAccountBalances(balances []types.AccountBalance) error
+
 	// SaveAverageBlockTimeGenesis stores the average
 	// block time from genesis.
 	// An error is returned if the operation fails.
@@ -81,10 +165,34 @@ type Database interface {
 	// NOTE. For each transaction inside txs, SaveTx will be called as well.
 	SaveBlock(block *types.Block) error
 
+	// SaveBtcCheckpoints stores the given nBTC bridge checkpoints, along with their signatory set, in database.
+	// An error is returned if the operation fails.
+	SaveBtcCheckpoints(checkpoints []types.BtcCheckpoint, height int64) error
+
+	// SaveBtcDeposits stores the given nBTC bridge deposits in database.
+	// An error is returned if the operation fails.
+	SaveBtcDeposits(deposits []types.BtcDeposit) error
+
+	// SaveBtcWithdrawals stores the given nBTC bridge withdrawals in database.
+	// An error is returned if the operation fails.
+	SaveBtcWithdrawals(withdrawals []types.BtcWithdrawal) error
+
 	// SaveCommitSignatures stores a  slice of validator commit signatures.
 	// An error is returned if the operation fails.
 	SaveCommitSignatures(signatures []*types.CommitSig) error
 
+	// SaveDecentralizationMetrics stores the given decentralization metrics in database.
+	// An error is returned if the operation fails.
+	SaveDecentralizationMetrics(metrics types.DecentralizationMetrics) error
+
+	// SaveDelegatorDelegations replaces the delegations, unbonding delegations and redelegations
+	// of the given delegator with the provided ones, unless a more recent refresh is already stored.
+	// An error is returned if the operation fails.
+	SaveDelegatorDelegations(
+		delegator string, delegations []types.Delegation, unbondings []types.UnbondingDelegation,
+		redelegations []types.Redelegation, height int64,
+	) error
+
 	// SaveDoubleSignEvidence stores double sign record in database.
 	// An error is returned if the operation fails.
 	SaveDoubleSignEvidence(evidence types.DoubleSignEvidence) error
@@ -93,13 +201,49 @@ type Database interface {
 	// An error is returned if the operation fails.
 	SaveGenesis(genesis *types.Genesis) error
 
+	// SaveIBCChannels stores the given IBC channels in database.
+	// An error is returned if the operation fails.
+	SaveIBCChannels(channels []types.IBCChannel) error
+
+	// SaveIBCClients stores the given IBC light clients in database.
+	// An error is returned if the operation fails.
+	SaveIBCClients(clients []types.IBCClient) error
+
+	// SaveIBCConnections stores the given IBC connections in database.
+	// An error is returned if the operation fails.
+	SaveIBCConnections(connections []types.IBCConnection) error
+
+	// SaveIBCDenomTraces stores the given IBC denom traces in database, registering each voucher
+	// denom as an alias of its base token unit. An error is returned if the operation fails.
+	SaveIBCDenomTraces(traces []types.IBCDenomTrace) error
+
 	// SaveIBCTransferParams stores the ibc transfer params value in database.
 	// An error is returned if the operation fails.
 	SaveIBCTransferParams(params *types.IBCTransferParams) error
 
-	// SaveInflation stores the inflation value in database.
+	// SaveIBCPackets stores the given IBC packets lifecycle steps in database, merging the steps
+	// of the same packet together. An error is returned if the operation fails.
+	SaveIBCPackets(packets []types.IBCPacket) error
+
+	// SaveIBCTokenTransfers stores the given IBC fungible token transfers in database.
+	// An error is returned if the operation fails.
+	SaveIBCTokenTransfers(transfers []types.IBCTokenTransfer) error
+
+	// SaveInflation stores the inflation value read at the given height in database, along with its history.
 	// An error is returned if the operation fails.
-	SaveInflation(inflation string, height int64) error
+	SaveInflation(inflation string, height int64, timestamp time.Time) error
+
+	// SaveLightClientAttackEvidence stores the given light client attack evidence in database.
+	// An error is returned if the operation fails.
+	SaveLightClientAttackEvidence(evidence types.LightClientAttackEvidence) error
+
+	// SaveRichList replaces the stored rich list, holders buckets and holders distribution with the given ones.
+	// An error is returned if the operation fails.
+	SaveRichList(richList types.RichList) error
+
+	// SaveStakingAPR stores the given staking APR in database.
+	// An error is returned if the operation fails.
+	SaveStakingAPR(apr types.StakingAPR) error
 
 	// SaveStakingPool stores the staking pool value in database.
 	// An error is returned if the operation fails.
@@ -109,11 +253,20 @@ type Database interface {
 	// An error is returned if the operation fails.
 	SaveSupply(coins sdk.Coins, height int64) error
 
+	// SaveSupplyHistory stores the given total supply changes in database.
+	// A coin having a zero amount tells that its denom has no supply anymore.
+	// An error is returned if the operation fails.
+	SaveSupplyHistory(changes []sdk.Coin, height int64) error
+
 	// SaveToken stores the token details in database.
 	// An error is returned if the operation fails.
 	SaveToken(token types.Token) error
 
-	// SaveTokensPrice stores tokens price in database.
+	// SaveTokenPricesHistory stores the given tokens prices inside the prices history.
+	// An error is returned if the operation fails.
+	SaveTokenPricesHistory(prices []types.TokenPrice) error
+
+	// SaveTokensPrice stores tokens price in database, along with their history.
 	// An error is returned if the operation fails.
 	SaveTokensPrice(prices []types.TokenPrice) error
 
@@ -121,10 +274,36 @@ type Database interface {
 	// An error is returned if the operation fails.
 	SaveTx(tx types.TxResponse) error
 
+	// SaveValidatorSet stores the given validator set entries, registering the validators that are not stored
+	// inside the validator table yet as unknown validators.
+	// An error is returned if the operation fails.
+	SaveValidatorSet(entries []types.ValidatorSetEntry) error
+
+	// SaveValidatorsSignatures updates the uptime of the validators using the given commit signatures,
+	// keeping a rolling window of the latest windowSize heights. All the signatures of a height must be
+	// given together, since heights that have already been processed are ignored.
+	// An error is returned if the operation fails.
+	SaveValidatorsSignatures(signatures []types.ValidatorSignature, windowSize int64) error
+
+	// SaveValidatorsSlashing stores the given validators slash and jail events, linking each of them
+	// to the evidence that caused it when it has been included inside the same block.
+	// An error is returned if the operation fails.
+	SaveValidatorsSlashing(slashing []types.ValidatorSlashing) error
+
 	// SaveValidators stores a list of validators in database.
 	// An error is returned if the operation fails.
 	SaveValidators(validators []types.Validator) error
 
+	// SaveValidatorsInfo stores the given validators data replacing the current one, along with its changes
+	// inside the history tables and the resulting validator events, using a single database transaction.
+	// An error is returned if the operation fails.
+	SaveValidatorsInfo(info types.ValidatorsInfo) error
+
+	// SaveValidatorAvatar caches the given validator avatar in database, and sets it as the avatar
+	// of all the validators having its identity.
+	// An error is returned if the operation fails.
+	SaveValidatorAvatar(avatar types.ValidatorAvatar) error
+
 	// SaveValidatorCommission stores validators commission value in database.
 	// An error is returned if the operation fails.
 	SaveValidatorCommission(data []types.ValidatorCommission) error
@@ -140,6 +319,23 @@ type Database interface {
 	// SaveValidatorsVotingPower stores a list of validators voting power in database.
 	// An error is returned if the operation fails.
 	SaveValidatorsVotingPower(entries []types.ValidatorVotingPower) error
+
+	// UpdateChainStats computes the chain statistics having the given granularity for all the
+	// periods between from (included) and to (excluded), and stores them inside the database.
+	// An error is returned if the operation fails.
+	UpdateChainStats(granularity types.StatsGranularity, from, to time.Time) error
+
+	// UpdateProposerStats computes the validators proposer statistics for all the dates
+	// between from (included) and to (excluded), and stores them inside the database.
+	// The expected proposals are computed from the validator set stored for each block,
+	// so they require the validator set to be stored using the "all" mode.
+	// An error is returned if the operation fails.
+	UpdateProposerStats(from, to time.Time) error
+
+	// UpdateTokenPriceCandles computes the OHLC token price candles having the given granularity for all the
+	// periods between from (included) and to (excluded), and stores them inside the database.
+	// An error is returned if the operation fails.
+	UpdateTokenPriceCandles(granularity types.StatsGranularity, from, to time.Time) error
 }
 
 // PruningDb represents a database that supports pruning properly
@@ -154,6 +350,42 @@ type PruningDb interface {
 	StoreLastPruned(height int64) error
 }
 
+// ExportDb represents a database that allows to read the indexed data by height ranges so that it can be exported
+type ExportDb interface {
+	// GetBlockHeightsInTimeRange returns the lowest and highest heights of the blocks having a timestamp
+	// between from and to (both included)
+	GetBlockHeightsInTimeRange(from, to time.Time) (int64, int64, error)
+
+	// GetBlocksInRange returns all the blocks having a height between from and to (both included)
+	GetBlocksInRange(from, to int64) ([]dbtypes.BlockRow, error)
+
+	// GetPreCommitsInRange returns all the pre commits having a height between from and to (both included)
+	GetPreCommitsInRange(from, to int64) ([]dbtypes.PreCommitRow, error)
+
+	// GetTransactionsInRange returns all the transactions having a height between from and to (both included)
+	GetTransactionsInRange(from, to int64) ([]dbtypes.TransactionRow, error)
+
+	// GetValidatorsInRange returns all the validators stored at a height between from and to (both included)
+	GetValidatorsInRange(from, to int64) ([]dbtypes.ValidatorRow, error)
+}
+
+// VerificationDb represents a database that allows to store the results of the consistency verification
+type VerificationDb interface {
+	// DeleteReprocessedData removes the data that is written again when the given height is reprocessed,
+	// which are the transactions of the height and the commit signatures contained inside its block
+	// (stored with the previous height), so that no stale row is left behind
+	DeleteReprocessedData(height int64) error
+
+	// GetLastVerifiedHeight returns the last height verified while sweeping the stored blocks
+	GetLastVerifiedHeight() (int64, error)
+
+	// SaveVerificationIssues stores the given verification issues
+	SaveVerificationIssues(issues []types.VerificationIssue) error
+
+	// StoreLastVerifiedHeight saves the last height verified while sweeping the stored blocks
+	StoreLastVerifiedHeight(height int64) error
+}
+
 // Context contains the data that might be used to build a Database instance
 type Context struct {
 	Cfg            databaseconfig.Config
@@ -0,0 +1,8 @@
+/*
+ * Adds the signers column to the transaction table of the deployments created before the stats module.
+ * The signers of the transactions stored before this migration are not known, so the unique signers of the
+ * periods covered by them are only correct after those transactions have been parsed again (njuno parse transactions all)
+ * and the stats of the same periods have been recomputed (njuno parse stats)
+ */
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS signers TEXT[] NOT NULL DEFAULT '{}';
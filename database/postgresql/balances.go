@@ -0,0 +1,119 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/types"
+)
+
+// GetAccountBalance implements database.Database
+func (db *Database) GetAccountBalance(address string, height int64) (*types.AccountBalance, error) {
+	// Read the latest balance from the account_balance table, and the past ones from the history
+	stmt := `SELECT height FROM account_balance WHERE address = $1`
+	params := []interface{}{address}
+	coinsStmt := `
+SELECT (coin).denom, (coin).amount 
+FROM account_balance, unnest(account_balance.coins) AS coin 
+WHERE address = $1`
+	if height > 0 {
+		stmt = `
+SELECT height FROM account_balance_history 
+WHERE address = $1 AND height <= $2 
+ORDER BY height DESC 
+LIMIT 1`
+		params = append(params, height)
+		coinsStmt = `
+SELECT (coin).denom, (coin).amount 
+FROM account_balance_history, unnest(account_balance_history.coins) AS coin 
+WHERE address = $1 AND height = $2`
+	}
+
+	var balanceHeight int64
+	err := db.Sql.QueryRow(stmt, params...).Scan(&balanceHeight)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account balance height: %s", err)
+	}
+
+	coinsParams := []interface{}{address}
+	if height > 0 {
+		coinsParams = append(coinsParams, balanceHeight)
+	}
+
+	rows, err := db.Sql.Query(coinsStmt, coinsParams...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account balance: %s", err)
+	}
+	defer rows.Close()
+
+	balance := sdk.NewCoins()
+	for rows.Next() {
+		var denom, amount string
+		err = rows.Scan(&denom, &amount)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning account balance: %s", err)
+		}
+
+		value, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s balance amount: %s", denom, amount)
+		}
+		balance = balance.Add(sdk.NewCoin(denom, value))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := types.NewAccountBalance(address, balance, balanceHeight)
+	return &result, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveAccountBalances implements database.Database
+func (db *Database) SaveAccountBalances(balances []types.AccountBalance) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account_balance (address, coins, height) VALUES `
+	historyStmt := `INSERT INTO account_balance_history (address, coins, height) VALUES `
+	var params []interface{}
+
+	for i, balance := range balances {
+		vi := i * 3
+		stmt += fmt.Sprintf("($%d,$%d,$%d),", vi+1, vi+2, vi+3)
+		historyStmt += fmt.Sprintf("($%d,$%d,$%d),", vi+1, vi+2, vi+3)
+		params = append(params, balance.Address, pq.Array(dbtypes.NewDbCoins(balance.Balance)), balance.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+    SET coins = excluded.coins, 
+        height = excluded.height 
+WHERE account_balance.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing accounts balance: %s", err)
+	}
+
+	historyStmt = historyStmt[:len(historyStmt)-1]
+	historyStmt += `
+ON CONFLICT (address, height) DO UPDATE 
+    SET coins = excluded.coins`
+	_, err = db.Sql.Exec(historyStmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing accounts balance history: %s", err)
+	}
+
+	return nil
+}
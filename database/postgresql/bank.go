@@ -8,6 +8,39 @@ import (
 	"github.com/lib/pq"
 )
 
+// GetSupply implements database.Database
+func (db *Database) GetSupply() (sdk.Coins, int64, error) {
+	stmt := `
+SELECT (coin).denom, (coin).amount, height
+FROM supply, unnest(supply.coins) AS coin`
+
+	rows, err := db.Sql.Query(stmt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error while getting supply: %s", err)
+	}
+	defer rows.Close()
+
+	var supply sdk.Coins
+	var height int64
+	for rows.Next() {
+		var denom, amount string
+		err = rows.Scan(&denom, &amount, &height)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error while scanning supply: %s", err)
+		}
+
+		value, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid %s supply amount: %s", denom, amount)
+		}
+		supply = supply.Add(sdk.NewCoin(denom, value))
+	}
+
+	return supply, height, rows.Err()
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // SaveSupply allows to store total supply for a given height
 func (db *Database) SaveSupply(coins sdk.Coins, height int64) error {
 	query := `
@@ -25,3 +58,32 @@ WHERE supply.height <= excluded.height`
 
 	return nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveSupplyHistory implements database.Database
+func (db *Database) SaveSupplyHistory(changes []sdk.Coin, height int64) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO supply_history (denom, amount, height) VALUES `
+	var params []interface{}
+
+	for i, coin := range changes {
+		vi := i * 3
+		stmt += fmt.Sprintf("($%d,$%d,$%d),", vi+1, vi+2, vi+3)
+		params = append(params, coin.Denom, coin.Amount.String(), height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (denom, height) DO UPDATE 
+    SET amount = excluded.amount`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing supply history: %s", err)
+	}
+
+	return nil
+}
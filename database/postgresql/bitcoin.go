@@ -0,0 +1,121 @@
+package postgresql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/types"
+)
+
+// SaveBtcDeposits implements database.Database
+func (db *Database) SaveBtcDeposits(deposits []types.BtcDeposit) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO btc_deposit (txid, vout, receiver_address, amount, transaction_hash, height) 
+VALUES `
+	var params []interface{}
+
+	for i, deposit := range deposits {
+		vi := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6)
+		params = append(params, deposit.Txid, deposit.Vout, deposit.ReceiverAddress, deposit.Amount.String(),
+			dbtypes.ToNullString(deposit.TxHash), deposit.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (txid, vout) DO UPDATE 
+    SET receiver_address = excluded.receiver_address,
+        amount = excluded.amount,
+        transaction_hash = excluded.transaction_hash,
+        height = excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing btc deposits: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveBtcWithdrawals implements database.Database
+func (db *Database) SaveBtcWithdrawals(withdrawals []types.BtcWithdrawal) error {
+	if len(withdrawals) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO btc_withdrawal (transaction_hash, msg_index, sender_address, destination_address, amount, height) 
+VALUES `
+	var params []interface{}
+
+	for i, withdrawal := range withdrawals {
+		vi := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6)
+
+		amount := dbtypes.NewDbCoin(withdrawal.Amount)
+		params = append(params, withdrawal.TxHash, withdrawal.MsgIndex, withdrawal.SenderAddress,
+			withdrawal.DestinationAddress, &amount, withdrawal.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_hash, msg_index) DO NOTHING`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing btc withdrawals: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveBtcCheckpoints implements database.Database
+func (db *Database) SaveBtcCheckpoints(checkpoints []types.BtcCheckpoint, height int64) error {
+	if len(checkpoints) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO btc_checkpoint (index, status, txid, fee_rate, signatory_set_index, signatory_set, height) 
+VALUES `
+	var params []interface{}
+
+	for i, checkpoint := range checkpoints {
+		vi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+
+		signatorySet, err := json.Marshal(checkpoint.SignatorySet)
+		if err != nil {
+			return fmt.Errorf("error while marshaling signatory set of checkpoint %d: %s", checkpoint.Index, err)
+		}
+
+		params = append(params, checkpoint.Index, checkpoint.Status, dbtypes.ToNullString(checkpoint.Txid),
+			checkpoint.FeeRate, checkpoint.SignatorySet.Index, string(signatorySet), height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (index) DO UPDATE 
+    SET status = excluded.status,
+        txid = excluded.txid,
+        fee_rate = excluded.fee_rate,
+        signatory_set_index = excluded.signatory_set_index,
+        signatory_set = excluded.signatory_set,
+        height = excluded.height
+WHERE btc_checkpoint.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing btc checkpoints: %s", err)
+	}
+
+	return nil
+}
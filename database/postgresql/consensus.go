@@ -37,7 +37,7 @@ func (db *Database) getBlockHeightTime(pastTime time.Time) (dbtypes.BlockRow, er
 	stmt := `SELECT * FROM block WHERE block.timestamp <= $1 ORDER BY block.timestamp DESC LIMIT 1;`
 
 	var val []dbtypes.BlockRow
-	if err := db.Sqlx.Select(&val, stmt, pastTime); err != nil {
+	if err := db.selectReadOnly(&val, stmt, pastTime); err != nil {
 		return dbtypes.BlockRow{}, err
 	}
 
@@ -53,7 +53,7 @@ func (db *Database) getBlockHeightTime(pastTime time.Time) (dbtypes.BlockRow, er
 // GetGenesis returns the genesis information stored inside the database
 func (db *Database) GetGenesis() (*types.Genesis, error) {
 	var rows []*dbtypes.GenesisRow
-	err := db.Sqlx.Select(&rows, `SELECT * FROM genesis;`)
+	err := db.selectReadOnly(&rows, `SELECT * FROM genesis;`)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,7 @@ func (db *Database) GetLastBlock() (*dbtypes.BlockRow, error) {
 	stmt := `SELECT * FROM block ORDER BY height DESC LIMIT 1`
 
 	var blocks []dbtypes.BlockRow
-	if err := db.Sqlx.Select(&blocks, stmt); err != nil {
+	if err := db.selectReadOnly(&blocks, stmt); err != nil {
 		return nil, err
 	}
 
@@ -109,6 +109,46 @@ func (db *Database) HasBlock(height int64) (bool, error) {
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// GetMissingBlocksRanges implements database.Database
+func (db *Database) GetMissingBlocksRanges(from, to int64) ([]types.HeightRange, error) {
+	// The two bounds are added as sentinel heights so that gaps at the start and end of the range are found too
+	stmt := `
+SELECT gap_start, gap_end
+FROM (
+    SELECT height + 1                           AS gap_start,
+           LEAD(height) OVER (ORDER BY height) - 1 AS gap_end
+    FROM (
+        SELECT $1::BIGINT - 1 AS height
+        UNION ALL
+        SELECT height FROM block WHERE height BETWEEN $1 AND $2
+        UNION ALL
+        SELECT $2::BIGINT + 1
+    ) AS heights
+) AS gaps
+WHERE gap_end >= gap_start
+ORDER BY gap_start`
+
+	rows, err := db.Sqlx.Query(stmt, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting missing blocks ranges: %s", err)
+	}
+	defer rows.Close()
+
+	var ranges []types.HeightRange
+	for rows.Next() {
+		var start, end int64
+		err = rows.Scan(&start, &end)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning missing blocks range: %s", err)
+		}
+		ranges = append(ranges, types.NewHeightRange(start, end))
+	}
+
+	return ranges, rows.Err()
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // SaveAverageBlockTimeGenesis save the average block time in average_block_time_from_genesis table
 func (db *Database) SaveAverageBlockTimeGenesis(averageTime float64, height int64) error {
 	stmt := `
@@ -193,7 +233,13 @@ WHERE average_block_time_per_minute.height <= excluded.height`
 func (db *Database) SaveBlock(block *types.Block) error {
 	sqlStatement := `
 INSERT INTO block (height, hash, num_txs, total_gas, proposer_address, timestamp)
-VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING`
+VALUES ($1, $2, $3, $4, $5, $6) 
+ON CONFLICT (height) DO UPDATE 
+    SET hash = excluded.hash,
+        num_txs = excluded.num_txs,
+        total_gas = excluded.total_gas,
+        proposer_address = excluded.proposer_address,
+        timestamp = excluded.timestamp`
 
 	proposerAddress := sql.NullString{Valid: len(block.ProposerAddress) != 0, String: block.ProposerAddress}
 	_, err := db.Sql.Exec(sqlStatement,
@@ -0,0 +1,68 @@
+package postgresql_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestGetMissingBlocksRanges() {
+	timestamp := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	for _, height := range []int64{3, 4, 7, 10} {
+		err := suite.database.SaveBlock(types.NewBlock(height, fmt.Sprintf("HASH%d", height), 0, 0, "proposer", timestamp))
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		from     int64
+		to       int64
+		expected []types.HeightRange
+	}{
+		{
+			name: "gaps at the start, middle and end of the range",
+			from: 1,
+			to:   12,
+			expected: []types.HeightRange{
+				types.NewHeightRange(1, 2),
+				types.NewHeightRange(5, 6),
+				types.NewHeightRange(8, 9),
+				types.NewHeightRange(11, 12),
+			},
+		},
+		{
+			name:     "range bounds on stored blocks",
+			from:     3,
+			to:       7,
+			expected: []types.HeightRange{types.NewHeightRange(5, 6)},
+		},
+		{
+			name:     "no missing blocks",
+			from:     3,
+			to:       4,
+			expected: nil,
+		},
+		{
+			name:     "no stored blocks",
+			from:     20,
+			to:       25,
+			expected: []types.HeightRange{types.NewHeightRange(20, 25)},
+		},
+		{
+			name:     "single missing height",
+			from:     5,
+			to:       5,
+			expected: []types.HeightRange{types.NewHeightRange(5, 5)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ranges, err := suite.database.GetMissingBlocksRanges(tc.from, tc.to)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, ranges)
+		})
+	}
+}
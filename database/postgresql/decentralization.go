@@ -0,0 +1,88 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// GetDecentralizationMetrics implements database.Database
+func (db *Database) GetDecentralizationMetrics(height int64) (*types.DecentralizationMetrics, error) {
+	stmt := `
+SELECT nakamoto_coefficient_33, nakamoto_coefficient_67, gini_coefficient, top_10_share, top_20_share,
+       active_set_size, total_voting_power, height, timestamp
+FROM decentralization_metrics
+WHERE $1 <= 0 OR height <= $1
+ORDER BY height DESC
+LIMIT 1`
+
+	var metrics types.DecentralizationMetrics
+	var gini, top10Share, top20Share, totalVotingPower string
+	err := db.Sql.QueryRow(stmt, height).Scan(
+		&metrics.NakamotoCoefficient33, &metrics.NakamotoCoefficient67, &gini, &top10Share, &top20Share,
+		&metrics.ActiveSetSize, &totalVotingPower, &metrics.Height, &metrics.Timestamp,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting decentralization metrics: %s", err)
+	}
+
+	metrics.GiniCoefficient, err = sdk.NewDecFromStr(gini)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing gini coefficient: %s", err)
+	}
+
+	metrics.Top10Share, err = sdk.NewDecFromStr(top10Share)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing top 10 share: %s", err)
+	}
+
+	metrics.Top20Share, err = sdk.NewDecFromStr(top20Share)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing top 20 share: %s", err)
+	}
+
+	var ok bool
+	metrics.TotalVotingPower, ok = sdk.NewIntFromString(totalVotingPower)
+	if !ok {
+		return nil, fmt.Errorf("invalid total voting power: %s", totalVotingPower)
+	}
+
+	return &metrics, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveDecentralizationMetrics implements database.Database
+func (db *Database) SaveDecentralizationMetrics(metrics types.DecentralizationMetrics) error {
+	stmt := `
+INSERT INTO decentralization_metrics 
+    (height, timestamp, nakamoto_coefficient_33, nakamoto_coefficient_67, gini_coefficient, 
+     top_10_share, top_20_share, active_set_size, total_voting_power)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (height) DO UPDATE 
+    SET timestamp = excluded.timestamp,
+        nakamoto_coefficient_33 = excluded.nakamoto_coefficient_33,
+        nakamoto_coefficient_67 = excluded.nakamoto_coefficient_67,
+        gini_coefficient = excluded.gini_coefficient,
+        top_10_share = excluded.top_10_share,
+        top_20_share = excluded.top_20_share,
+        active_set_size = excluded.active_set_size,
+        total_voting_power = excluded.total_voting_power`
+
+	_, err := db.Sql.Exec(stmt,
+		metrics.Height, metrics.Timestamp, metrics.NakamotoCoefficient33, metrics.NakamotoCoefficient67,
+		metrics.GiniCoefficient.String(), metrics.Top10Share.String(), metrics.Top20Share.String(),
+		metrics.ActiveSetSize, metrics.TotalVotingPower.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing decentralization metrics: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/types"
+)
+
+// GetDelegatorsAddresses implements database.Database
+func (db *Database) GetDelegatorsAddresses() ([]string, error) {
+	stmt := `
+SELECT delegator_address FROM delegation
+UNION
+SELECT delegator_address FROM unbonding_delegation
+UNION
+SELECT delegator_address FROM redelegation`
+
+	var addresses []string
+	err := db.Sqlx.Select(&addresses, stmt)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting delegators addresses: %s", err)
+	}
+
+	return addresses, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveDelegatorDelegations implements database.Database
+func (db *Database) SaveDelegatorDelegations(
+	delegator string, delegations []types.Delegation, unbondings []types.UnbondingDelegation,
+	redelegations []types.Redelegation, height int64,
+) error {
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning delegations transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// Store the refresh height, skipping the refresh if a more recent one has already been stored.
+	// The row is kept even when the delegator has no more delegations, so that an older refresh
+	// can never bring back the removed rows. Concurrent refreshes of the same delegator are serialized
+	// by the lock acquired on this row.
+	var refreshHeight int64
+	err = tx.QueryRow(`
+INSERT INTO delegations_refresh (delegator_address, height) 
+VALUES ($1, $2)
+ON CONFLICT (delegator_address) DO UPDATE 
+    SET height = excluded.height
+WHERE delegations_refresh.height <= excluded.height
+RETURNING height`, delegator, height).Scan(&refreshHeight)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error while storing delegations refresh height of %s: %s", delegator, err)
+	}
+
+	for _, table := range []string{"delegation", "unbonding_delegation", "redelegation"} {
+		_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE delegator_address = $1`, table), delegator)
+		if err != nil {
+			return fmt.Errorf("error while deleting %s rows of %s: %s", table, delegator, err)
+		}
+	}
+
+	err = saveDelegations(tx, delegations)
+	if err != nil {
+		return err
+	}
+
+	err = saveUnbondingDelegations(tx, unbondings)
+	if err != nil {
+		return err
+	}
+
+	err = saveRedelegations(tx, redelegations)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveDelegations stores the given delegations using the provided transaction
+func saveDelegations(tx *sql.Tx, delegations []types.Delegation) error {
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO delegation (delegator_address, validator_address, amount, shares, height) VALUES `
+	var params []interface{}
+
+	for i, delegation := range delegations {
+		vi := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+
+		amount := dbtypes.NewDbCoin(delegation.Amount)
+		params = append(params, delegation.DelegatorAddress, delegation.ValidatorAddress, &amount,
+			delegation.Shares, delegation.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegations: %s", err)
+	}
+
+	return nil
+}
+
+// saveUnbondingDelegations stores the given unbonding delegations using the provided transaction
+func saveUnbondingDelegations(tx *sql.Tx, unbondings []types.UnbondingDelegation) error {
+	if len(unbondings) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO unbonding_delegation
+    (delegator_address, validator_address, initial_balance, balance, creation_height, completion_timestamp, height)
+VALUES `
+	var params []interface{}
+
+	for i, unbonding := range unbondings {
+		vi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+		params = append(params, unbonding.DelegatorAddress, unbonding.ValidatorAddress, unbonding.InitialBalance,
+			unbonding.Balance, unbonding.CreationHeight, unbonding.CompletionTimestamp, unbonding.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing unbonding delegations: %s", err)
+	}
+
+	return nil
+}
+
+// saveRedelegations stores the given redelegations using the provided transaction
+func saveRedelegations(tx *sql.Tx, redelegations []types.Redelegation) error {
+	if len(redelegations) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO redelegation
+    (delegator_address, src_validator_address, dst_validator_address, initial_balance, balance,
+     creation_height, completion_time, height)
+VALUES `
+	var params []interface{}
+
+	for i, redelegation := range redelegations {
+		vi := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8)
+		params = append(params, redelegation.DelegatorAddress, redelegation.SrcValidatorAddress,
+			redelegation.DstValidatorAddress, redelegation.InitialBalance, redelegation.Balance,
+			redelegation.CreationHeight, redelegation.CompletionTime, redelegation.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing redelegations: %s", err)
+	}
+
+	return nil
+}
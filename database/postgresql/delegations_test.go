@@ -0,0 +1,66 @@
+package postgresql_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestSaveDelegatorDelegations() {
+	delegator := "nomic1delegator"
+	completion := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	countRows := func(table string) int {
+		var count int
+		err := suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM `+table+` WHERE delegator_address = $1`, delegator)
+		suite.Require().NoError(err)
+		return count
+	}
+
+	// Store the delegations refreshed at height 10
+	err := suite.database.SaveDelegatorDelegations(
+		delegator,
+		[]types.Delegation{types.NewDelegation(delegator, "val1", sdk.NewInt64Coin("unom", 100), "100", 10)},
+		[]types.UnbondingDelegation{types.NewUnbondingDelegation(delegator, "val2", "50", "50", 8, completion, 10)},
+		nil,
+		10,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, countRows("delegation"))
+	suite.Require().Equal(1, countRows("unbonding_delegation"))
+
+	// A full undelegation at height 20 removes all the rows
+	err = suite.database.SaveDelegatorDelegations(delegator, nil, nil, nil, 20)
+	suite.Require().NoError(err)
+	suite.Require().Zero(countRows("delegation"))
+	suite.Require().Zero(countRows("unbonding_delegation"))
+
+	// An older refresh must not bring the removed rows back
+	err = suite.database.SaveDelegatorDelegations(
+		delegator,
+		[]types.Delegation{types.NewDelegation(delegator, "val1", sdk.NewInt64Coin("unom", 100), "100", 15)},
+		nil,
+		nil,
+		15,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Zero(countRows("delegation"))
+
+	// A newer refresh is stored
+	err = suite.database.SaveDelegatorDelegations(
+		delegator,
+		[]types.Delegation{types.NewDelegation(delegator, "val3", sdk.NewInt64Coin("unom", 10), "10", 25)},
+		nil,
+		nil,
+		25,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, countRows("delegation"))
+
+	var height int64
+	err = suite.database.Sqlx.Get(&height, `SELECT height FROM delegations_refresh WHERE delegator_address = $1`, delegator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(25), height)
+}
@@ -0,0 +1,78 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/database"
+	dbtypes "github.com/forbole/njuno/database/types"
+)
+
+// type check to ensure interface is properly implemented
+var _ database.ExportDb = &Database{}
+
+// GetBlockHeightsInTimeRange implements database.ExportDb
+func (db *Database) GetBlockHeightsInTimeRange(from, to time.Time) (int64, int64, error) {
+	stmt := `SELECT MIN(height), MAX(height) FROM block WHERE timestamp >= $1 AND timestamp <= $2`
+
+	var minHeight, maxHeight sql.NullInt64
+	err := db.Sql.QueryRow(stmt, from.UTC(), to.UTC()).Scan(&minHeight, &maxHeight)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error while getting block heights in time range: %s", err)
+	}
+
+	if !minHeight.Valid || !maxHeight.Valid {
+		return 0, 0, fmt.Errorf("no blocks stored between %s and %s", from, to)
+	}
+
+	return minHeight.Int64, maxHeight.Int64, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetBlocksInRange implements database.ExportDb
+func (db *Database) GetBlocksInRange(from, to int64) ([]dbtypes.BlockRow, error) {
+	stmt := `SELECT * FROM block WHERE height >= $1 AND height <= $2 ORDER BY height`
+
+	var rows []dbtypes.BlockRow
+	err := db.selectReadOnly(&rows, stmt, from, to)
+	return rows, err
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetPreCommitsInRange implements database.ExportDb
+func (db *Database) GetPreCommitsInRange(from, to int64) ([]dbtypes.PreCommitRow, error) {
+	stmt := `SELECT * FROM pre_commit WHERE height >= $1 AND height <= $2 ORDER BY height, validator_address`
+
+	var rows []dbtypes.PreCommitRow
+	err := db.selectReadOnly(&rows, stmt, from, to)
+	return rows, err
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetTransactionsInRange implements database.ExportDb
+func (db *Database) GetTransactionsInRange(from, to int64) ([]dbtypes.TransactionRow, error) {
+	stmt := `
+SELECT hash, height, memo, signatures, signers, fee::TEXT AS fee, gas, partition_id 
+FROM transaction 
+WHERE height >= $1 AND height <= $2 
+ORDER BY height, hash`
+
+	var rows []dbtypes.TransactionRow
+	err := db.selectReadOnly(&rows, stmt, from, to)
+	return rows, err
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetValidatorsInRange implements database.ExportDb
+func (db *Database) GetValidatorsInRange(from, to int64) ([]dbtypes.ValidatorRow, error) {
+	stmt := `SELECT * FROM validator WHERE height >= $1 AND height <= $2 ORDER BY height, self_delegate_address`
+
+	var rows []dbtypes.ValidatorRow
+	err := db.selectReadOnly(&rows, stmt, from, to)
+	return rows, err
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 
+	dbtypes "github.com/forbole/njuno/database/types"
 	"github.com/forbole/njuno/types"
+	"github.com/lib/pq"
 )
 
 // SaveIBCTransferParams allows to store the given ibc transfer params inside the database
@@ -29,3 +31,278 @@ WHERE ibc_transfer_params.height <= excluded.height`
 
 	return nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveIBCPackets implements database.Database
+func (db *Database) SaveIBCPackets(packets []types.IBCPacket) error {
+	for _, packet := range packets {
+		err := db.saveIBCPacket(packet)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveIBCPacket stores the given packet lifecycle step, merging it with the steps already stored
+func (db *Database) saveIBCPacket(packet types.IBCPacket) error {
+	var sendTxHash, sendHeight, recvTxHash, recvHeight, recvRelayer, ackTxHash, ackHeight, ackRelayer interface{}
+	switch packet.Status {
+	case types.IBCPacketStatusSent:
+		sendTxHash, sendHeight = packet.TxHash, packet.Height
+	case types.IBCPacketStatusReceived:
+		recvTxHash, recvHeight, recvRelayer = packet.TxHash, packet.Height, dbtypes.ToNullString(packet.RelayerAddress)
+	default:
+		ackTxHash, ackHeight, ackRelayer = packet.TxHash, packet.Height, dbtypes.ToNullString(packet.RelayerAddress)
+	}
+
+	// The acknowledgement and timeout steps are final, so they are never overridden by the previous ones
+	stmt := `
+INSERT INTO ibc_packet 
+    (source_port, source_channel, sequence, destination_port, destination_channel, data, timeout_height, 
+     timeout_timestamp, status, send_tx_hash, send_height, recv_tx_hash, recv_height, recv_relayer_address, 
+     ack_tx_hash, ack_height, ack_relayer_address)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (source_port, source_channel, sequence, destination_port, destination_channel) DO UPDATE 
+    SET data = COALESCE(excluded.data, ibc_packet.data),
+        timeout_height = COALESCE(excluded.timeout_height, ibc_packet.timeout_height),
+        timeout_timestamp = COALESCE(excluded.timeout_timestamp, ibc_packet.timeout_timestamp),
+        status = CASE 
+            WHEN ibc_packet.status IN ('acknowledged', 'timeout') THEN ibc_packet.status 
+            WHEN excluded.status = 'sent' THEN ibc_packet.status
+            ELSE excluded.status END,
+        send_tx_hash = COALESCE(excluded.send_tx_hash, ibc_packet.send_tx_hash),
+        send_height = COALESCE(excluded.send_height, ibc_packet.send_height),
+        recv_tx_hash = COALESCE(excluded.recv_tx_hash, ibc_packet.recv_tx_hash),
+        recv_height = COALESCE(excluded.recv_height, ibc_packet.recv_height),
+        recv_relayer_address = COALESCE(excluded.recv_relayer_address, ibc_packet.recv_relayer_address),
+        ack_tx_hash = COALESCE(excluded.ack_tx_hash, ibc_packet.ack_tx_hash),
+        ack_height = COALESCE(excluded.ack_height, ibc_packet.ack_height),
+        ack_relayer_address = COALESCE(excluded.ack_relayer_address, ibc_packet.ack_relayer_address)`
+
+	_, err := db.Sql.Exec(stmt,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence, packet.DestinationPort, packet.DestinationChannel,
+		dbtypes.ToNullString(packet.Data), dbtypes.ToNullString(packet.TimeoutHeight), packet.TimeoutTimestamp,
+		packet.Status, sendTxHash, sendHeight, recvTxHash, recvHeight, recvRelayer, ackTxHash, ackHeight, ackRelayer,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc packet %s/%s/%d: %s",
+			packet.SourcePort, packet.SourceChannel, packet.Sequence, err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveIBCTokenTransfers implements database.Database
+func (db *Database) SaveIBCTokenTransfers(transfers []types.IBCTokenTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO ibc_transfer 
+    (source_port, source_channel, sequence, direction, channel, denom, amount, sender, receiver, 
+     transaction_hash, height, timestamp) 
+VALUES `
+	var params []interface{}
+
+	for i, transfer := range transfers {
+		vi := i * 12
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9, vi+10, vi+11, vi+12)
+		params = append(params, transfer.SourcePort, transfer.SourceChannel, transfer.Sequence, transfer.Direction,
+			transfer.Channel, transfer.Denom, transfer.Amount.String(), transfer.Sender, transfer.Receiver,
+			transfer.TxHash, transfer.Height, transfer.Timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (source_port, source_channel, sequence, direction, channel) DO NOTHING`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc transfers: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveIBCClients implements database.Database
+func (db *Database) SaveIBCClients(clients []types.IBCClient) error {
+	if len(clients) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO ibc_client 
+    (client_id, chain_id, trusting_period, latest_height, latest_timestamp, frozen_height, status, height) 
+VALUES `
+	var params []interface{}
+
+	for i, client := range clients {
+		vi := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8)
+
+		var latestTimestamp interface{}
+		if !client.LatestTimestamp.IsZero() {
+			latestTimestamp = client.LatestTimestamp
+		}
+
+		params = append(params, client.ClientID, client.ChainID, int64(client.TrustingPeriod.Seconds()),
+			client.LatestHeight, latestTimestamp, dbtypes.ToNullString(client.FrozenHeight), client.Status, client.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (client_id) DO UPDATE 
+    SET chain_id = excluded.chain_id,
+        trusting_period = excluded.trusting_period,
+        latest_height = excluded.latest_height,
+        latest_timestamp = excluded.latest_timestamp,
+        frozen_height = excluded.frozen_height,
+        status = excluded.status,
+        height = excluded.height
+WHERE ibc_client.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc clients: %s", err)
+	}
+
+	return nil
+}
+
+// SaveIBCConnections implements database.Database
+func (db *Database) SaveIBCConnections(connections []types.IBCConnection) error {
+	if len(connections) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO ibc_connection 
+    (connection_id, client_id, state, counterparty_client_id, counterparty_connection_id, delay_period, height) 
+VALUES `
+	var params []interface{}
+
+	for i, conn := range connections {
+		vi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+		params = append(params, conn.ConnectionID, conn.ClientID, conn.State, conn.CounterpartyClientID,
+			dbtypes.ToNullString(conn.CounterpartyConnectionID), conn.DelayPeriod, conn.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (connection_id) DO UPDATE 
+    SET client_id = excluded.client_id,
+        state = excluded.state,
+        counterparty_client_id = excluded.counterparty_client_id,
+        counterparty_connection_id = excluded.counterparty_connection_id,
+        delay_period = excluded.delay_period,
+        height = excluded.height
+WHERE ibc_connection.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc connections: %s", err)
+	}
+
+	return nil
+}
+
+// SaveIBCChannels implements database.Database
+func (db *Database) SaveIBCChannels(channels []types.IBCChannel) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO ibc_channel 
+    (port_id, channel_id, state, ordering, counterparty_port_id, counterparty_channel_id, connection_hops, 
+     version, height) 
+VALUES `
+	var params []interface{}
+
+	for i, channel := range channels {
+		vi := i * 9
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9)
+		params = append(params, channel.PortID, channel.ChannelID, channel.State, channel.Ordering,
+			channel.CounterpartyPortID, dbtypes.ToNullString(channel.CounterpartyChannelID),
+			pq.StringArray(channel.ConnectionHops), channel.Version, channel.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (port_id, channel_id) DO UPDATE 
+    SET state = excluded.state,
+        ordering = excluded.ordering,
+        counterparty_port_id = excluded.counterparty_port_id,
+        counterparty_channel_id = excluded.counterparty_channel_id,
+        connection_hops = excluded.connection_hops,
+        version = excluded.version,
+        height = excluded.height
+WHERE ibc_channel.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc channels: %s", err)
+	}
+
+	return nil
+}
+
+// SaveIBCDenomTraces implements database.Database
+func (db *Database) SaveIBCDenomTraces(traces []types.IBCDenomTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning ibc denom traces transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	stmt := `INSERT INTO ibc_denom_trace (denom, path, base_denom, height) VALUES `
+	var params []interface{}
+
+	for i, trace := range traces {
+		vi := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4)
+		params = append(params, trace.Denom, trace.Path, trace.BaseDenom, trace.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (denom) DO UPDATE 
+    SET path = excluded.path,
+        base_denom = excluded.base_denom,
+        height = excluded.height
+WHERE ibc_denom_trace.height <= excluded.height`
+
+	_, err = tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing ibc denom traces: %s", err)
+	}
+
+	// Register each voucher denom as an alias of the token unit it originates from,
+	// so that IBC balances can be displayed using the token names
+	aliasStmt := `
+UPDATE token_unit 
+    SET aliases = array_append(COALESCE(aliases, '{}'), $1)
+WHERE denom = $2 AND NOT ($1 = ANY(COALESCE(aliases, '{}')))`
+
+	for _, trace := range traces {
+		_, err = tx.Exec(aliasStmt, trace.Denom, trace.BaseDenom)
+		if err != nil {
+			return fmt.Errorf("error while storing ibc denom %s as token unit alias: %s", trace.Denom, err)
+		}
+	}
+
+	return tx.Commit()
+}
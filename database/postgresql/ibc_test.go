@@ -0,0 +1,103 @@
+package postgresql_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestSaveIBCPackets_CollidingSequences() {
+	// The packet sent through transfer/channel-0 and the one received from a counterparty that
+	// also uses transfer/channel-0 share the same source identifiers and sequence
+	err := suite.database.SaveIBCPackets([]types.IBCPacket{
+		{
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-5",
+			Sequence:           1,
+			Status:             types.IBCPacketStatusSent,
+			TxHash:             "SEND",
+			Height:             10,
+		},
+		{
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+			Sequence:           1,
+			Status:             types.IBCPacketStatusReceived,
+			TxHash:             "RECV",
+			RelayerAddress:     "nomic1relayer",
+			Height:             11,
+		},
+	})
+	suite.Require().NoError(err)
+
+	type packetRow struct {
+		DestinationChannel string  `db:"destination_channel"`
+		Status             string  `db:"status"`
+		SendTxHash         *string `db:"send_tx_hash"`
+		RecvTxHash         *string `db:"recv_tx_hash"`
+	}
+
+	var rows []packetRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT destination_channel, status, send_tx_hash, recv_tx_hash FROM ibc_packet ORDER BY destination_channel`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().Equal("channel-1", rows[0].DestinationChannel)
+	suite.Require().Equal(types.IBCPacketStatusReceived, rows[0].Status)
+	suite.Require().Nil(rows[0].SendTxHash)
+	suite.Require().Equal("RECV", *rows[0].RecvTxHash)
+
+	suite.Require().Equal("channel-5", rows[1].DestinationChannel)
+	suite.Require().Equal(types.IBCPacketStatusSent, rows[1].Status)
+	suite.Require().Equal("SEND", *rows[1].SendTxHash)
+	suite.Require().Nil(rows[1].RecvTxHash)
+}
+
+func (suite *DbTestSuite) TestSaveIBCTokenTransfers_CollidingSequences() {
+	timestamp := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	// Two transfers received through different channels from counterparties using the same source identifiers
+	err := suite.database.SaveIBCTokenTransfers([]types.IBCTokenTransfer{
+		{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Sequence:      1,
+			Direction:     types.IBCTransferDirectionIncoming,
+			Channel:       "channel-1",
+			Denom:         "uatom",
+			Amount:        sdk.NewInt(100),
+			Sender:        "cosmos1sender",
+			Receiver:      "nomic1receiver",
+			TxHash:        "RECV1",
+			Height:        10,
+			Timestamp:     timestamp,
+		},
+		{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Sequence:      1,
+			Direction:     types.IBCTransferDirectionIncoming,
+			Channel:       "channel-2",
+			Denom:         "uosmo",
+			Amount:        sdk.NewInt(200),
+			Sender:        "osmo1sender",
+			Receiver:      "nomic1receiver",
+			TxHash:        "RECV2",
+			Height:        11,
+			Timestamp:     timestamp,
+		},
+	})
+	suite.Require().NoError(err)
+
+	var channels []string
+	err = suite.database.Sqlx.Select(&channels, `SELECT channel FROM ibc_transfer ORDER BY channel`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"channel-1", "channel-2"}, channels)
+}
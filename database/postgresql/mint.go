@@ -1,9 +1,17 @@
 package postgresql
 
-import "fmt"
+import (
+	"database/sql"
+	"fmt"
+	"time"
 
-// SaveInflation allows to store the inflation for the given block height
-func (db *Database) SaveInflation(inflation string, height int64) error {
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// SaveInflation allows to store the inflation for the given block height, keeping track of its history
+func (db *Database) SaveInflation(inflation string, height int64, timestamp time.Time) error {
 	stmt := `
 INSERT INTO inflation (value, height) 
 VALUES ($1, $2) 
@@ -17,5 +25,94 @@ WHERE inflation.height <= excluded.height`
 		return fmt.Errorf("error while storing inflation: %s", err)
 	}
 
+	stmt = `
+INSERT INTO inflation_history (value, height, timestamp) 
+VALUES ($1, $2, $3) 
+ON CONFLICT (height) DO UPDATE 
+    SET value = excluded.value, 
+        timestamp = excluded.timestamp`
+
+	_, err = db.Sql.Exec(stmt, inflation, height, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while storing inflation history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetStakingAPR implements database.Database
+func (db *Database) GetStakingAPR(height int64) (*types.StakingAPR, error) {
+	stmt := `
+SELECT inflation, total_supply, bonded_tokens, average_commission, apr, height, timestamp
+FROM staking_apr
+WHERE $1 <= 0 OR height <= $1
+ORDER BY height DESC
+LIMIT 1`
+
+	var apr types.StakingAPR
+	var inflation, totalSupply, bondedTokens, averageCommission, value string
+	err := db.Sql.QueryRow(stmt, height).Scan(
+		&inflation, &totalSupply, &bondedTokens, &averageCommission, &value, &apr.Height, &apr.Timestamp,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking apr: %s", err)
+	}
+
+	apr.Inflation, err = sdk.NewDecFromStr(inflation)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing inflation: %s", err)
+	}
+
+	apr.AverageCommission, err = sdk.NewDecFromStr(averageCommission)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing average commission: %s", err)
+	}
+
+	apr.APR, err = sdk.NewDecFromStr(value)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing apr: %s", err)
+	}
+
+	var ok bool
+	apr.TotalSupply, ok = sdk.NewIntFromString(totalSupply)
+	if !ok {
+		return nil, fmt.Errorf("invalid total supply: %s", totalSupply)
+	}
+
+	apr.BondedTokens, ok = sdk.NewIntFromString(bondedTokens)
+	if !ok {
+		return nil, fmt.Errorf("invalid bonded tokens: %s", bondedTokens)
+	}
+
+	return &apr, nil
+}
+
+// SaveStakingAPR implements database.Database
+func (db *Database) SaveStakingAPR(apr types.StakingAPR) error {
+	stmt := `
+INSERT INTO staking_apr 
+    (height, timestamp, inflation, total_supply, bonded_tokens, average_commission, apr)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (height) DO UPDATE 
+    SET timestamp = excluded.timestamp,
+        inflation = excluded.inflation,
+        total_supply = excluded.total_supply,
+        bonded_tokens = excluded.bonded_tokens,
+        average_commission = excluded.average_commission,
+        apr = excluded.apr`
+
+	_, err := db.Sql.Exec(stmt,
+		apr.Height, apr.Timestamp, apr.Inflation.String(), apr.TotalSupply.String(), apr.BondedTokens.String(),
+		apr.AverageCommission.String(), apr.APR.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing staking apr: %s", err)
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package postgresql
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/forbole/njuno/logging"
 	"github.com/jmoiron/sqlx"
@@ -44,17 +45,39 @@ func Builder(ctx *database.Context) (database.Database, error) {
 	postgresDb.SetMaxOpenConns(ctx.Cfg.MaxOpenConnections)
 	postgresDb.SetMaxIdleConns(ctx.Cfg.MaxIdleConnections)
 
+	// Open the read replicas connections, if any
+	replicas := make([]*replica, len(ctx.Cfg.ReadReplicas))
+	for i, dsn := range ctx.Cfg.ReadReplicas {
+		replicas[i], err = newReplica(dsn, ctx.Cfg.MaxOpenConnections, ctx.Cfg.MaxIdleConnections)
+		if err != nil {
+			return nil, fmt.Errorf("error while opening read replica connection: %s", err)
+		}
+	}
+
+	maxReplicaLag := defaultMaxReplicaLag
+	if ctx.Cfg.MaxReplicaLag > 0 {
+		maxReplicaLag = ctx.Cfg.MaxReplicaLag
+	}
+
 	return &Database{
 		Sql:            postgresDb,
 		Sqlx:           sqlx.NewDb(postgresDb, "postgresql"),
 		EncodingConfig: ctx.EncodingConfig,
 		Logger:         ctx.Logger,
+		replicas:       replicas,
+		maxReplicaLag:  maxReplicaLag,
 	}, nil
 }
 
 // type check to ensure interface is properly implemented
 var _ database.Database = &Database{}
 
+// sqlExecutor represents either a database connection or a transaction, allowing the same statements
+// to be executed both on their own and as part of a larger transaction
+type sqlExecutor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
 // Database defines a wrapper around a SQL database and implements functionality
 // for data aggregation and exporting.
 type Database struct {
@@ -62,6 +85,11 @@ type Database struct {
 	Sqlx           *sqlx.DB
 	EncodingConfig *params.EncodingConfig
 	Logger         logging.Logger
+
+	// replicas contains the read-only connections used by the query-heavy paths
+	replicas      []*replica
+	maxReplicaLag time.Duration
+	nextReplica   uint32
 }
 
 // createPartitionIfNotExists creates a new partition having the given partition id if not existing
@@ -91,4 +119,11 @@ func (db *Database) Close() {
 	if err != nil {
 		db.Logger.Error("error while closing connection", "err", err)
 	}
+
+	for _, r := range db.replicas {
+		err = r.Sql.Close()
+		if err != nil {
+			db.Logger.Error("error while closing read replica connection", "err", err)
+		}
+	}
 }
@@ -44,6 +44,8 @@ func (suite *DbTestSuite) SetupTest() {
 		-1,
 		100000,
 		100,
+		nil,
+		0,
 	)
 	db, err := postgres.Builder(database.NewContext(dbCfg, &codec, logging.DefaultLogger()))
 	suite.Require().NoError(err)
@@ -51,6 +53,11 @@ func (suite *DbTestSuite) SetupTest() {
 	bigDipperDb, ok := (db).(*postgres.Database)
 	suite.Require().True(ok)
 
+	// Skip the database tests when no test database is running
+	if err = bigDipperDb.Sql.Ping(); err != nil {
+		suite.T().Skipf("test database not available: %s", err)
+	}
+
 	// Delete the public schema
 	_, err = bigDipperDb.Sql.Exec(`DROP SCHEMA public CASCADE;`)
 	suite.Require().NoError(err)
@@ -59,7 +66,7 @@ func (suite *DbTestSuite) SetupTest() {
 	_, err = bigDipperDb.Sql.Exec(`CREATE SCHEMA public;`)
 	suite.Require().NoError(err)
 
-	dirPath := path.Join(".")
+	dirPath := path.Join("..", "schema")
 	dir, err := ioutil.ReadDir(dirPath)
 	suite.Require().NoError(err)
 
@@ -79,5 +86,9 @@ func (suite *DbTestSuite) SetupTest() {
 		}
 	}
 
+	// Create the default transactions partition
+	_, err = bigDipperDb.Sql.Exec(`CREATE TABLE transaction_0 PARTITION OF transaction FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
 	suite.database = bigDipperDb
 }
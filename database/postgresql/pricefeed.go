@@ -1,7 +1,9 @@
 package postgresql
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/njuno/database/types"
 	"github.com/forbole/njuno/types"
@@ -12,7 +14,7 @@ func (db *Database) GetTokensPriceID() ([]string, error) {
 	query := `SELECT * FROM token_unit`
 
 	var dbUnits []dbtypes.TokenUnitRow
-	err := db.Sqlx.Select(&dbUnits, query)
+	err := db.selectReadOnly(&dbUnits, query)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +29,24 @@ func (db *Database) GetTokensPriceID() ([]string, error) {
 	return units, nil
 }
 
+// GetTokenUnitsByPriceID implements database.Database
+func (db *Database) GetTokenUnitsByPriceID() (map[string]string, error) {
+	query := `SELECT * FROM token_unit WHERE price_id IS NOT NULL AND price_id <> ''`
+
+	var dbUnits []dbtypes.TokenUnitRow
+	err := db.selectReadOnly(&dbUnits, query)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting token units: %s", err)
+	}
+
+	units := make(map[string]string, len(dbUnits))
+	for _, unit := range dbUnits {
+		units[unit.PriceID.String] = unit.Denom
+	}
+
+	return units, nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // SaveTokensPrices stores the latest tokens price
@@ -57,5 +77,110 @@ WHERE token_price.timestamp <= excluded.timestamp`
 		return fmt.Errorf("error while saving tokens prices: %s", err)
 	}
 
+	return db.SaveTokenPricesHistory(prices)
+}
+
+// SaveTokenPricesHistory implements database.Database
+func (db *Database) SaveTokenPricesHistory(prices []types.TokenPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO token_price_history (unit_name, price, market_cap, timestamp) VALUES`
+	var param []interface{}
+
+	for i, ticker := range prices {
+		vi := i * 4
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4)
+		param = append(param, ticker.UnitName, ticker.Price, ticker.MarketCap, ticker.Timestamp)
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT (unit_name, timestamp) DO UPDATE 
+	SET price = excluded.price,
+	    market_cap = excluded.market_cap`
+
+	_, err := db.Sql.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while saving tokens prices history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// getTokenPriceCandlesTable returns the name of the table containing the token price candles having the given granularity
+func getTokenPriceCandlesTable(granularity types.StatsGranularity) (string, error) {
+	switch granularity {
+	case types.StatsGranularityHour:
+		return "hourly_token_price_candle", nil
+	case types.StatsGranularityDay:
+		return "daily_token_price_candle", nil
+	default:
+		return "", fmt.Errorf("invalid token price candles granularity: %s", granularity)
+	}
+}
+
+// GetLastTokenPriceCandlePeriod implements database.Database
+func (db *Database) GetLastTokenPriceCandlePeriod(granularity types.StatsGranularity) (time.Time, error) {
+	table, err := getTokenPriceCandlesTable(granularity)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	stmt := fmt.Sprintf(`
+SELECT COALESCE(
+    (SELECT MAX(period) FROM %[1]s), 
+    (SELECT date_trunc('%[2]s', MIN(timestamp)) FROM token_price_history)
+)`, table, granularity)
+
+	var period sql.NullTime
+	err = db.Sql.QueryRow(stmt).Scan(&period)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error while getting last %s token price candle period: %s", granularity, err)
+	}
+
+	if !period.Valid {
+		return time.Time{}, nil
+	}
+
+	return period.Time, nil
+}
+
+// UpdateTokenPriceCandles implements database.Database
+func (db *Database) UpdateTokenPriceCandles(granularity types.StatsGranularity, from, to time.Time) error {
+	table, err := getTokenPriceCandlesTable(granularity)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`
+INSERT INTO %[1]s (unit_name, period, open, high, low, close, market_cap, samples)
+SELECT unit_name,
+       date_trunc('%[2]s', timestamp) AS period,
+       (array_agg(price ORDER BY timestamp ASC))[1],
+       MAX(price),
+       MIN(price),
+       (array_agg(price ORDER BY timestamp DESC))[1],
+       (array_agg(market_cap ORDER BY timestamp DESC))[1],
+       COUNT(*)
+FROM token_price_history
+WHERE timestamp >= $1 AND timestamp < $2
+GROUP BY 1, 2
+ON CONFLICT (unit_name, period) DO UPDATE 
+    SET open = excluded.open,
+        high = excluded.high,
+        low = excluded.low,
+        close = excluded.close,
+        market_cap = excluded.market_cap,
+        samples = excluded.samples`, table, granularity)
+
+	_, err = db.Sql.Exec(stmt, from, to)
+	if err != nil {
+		return fmt.Errorf("error while updating %s token price candles: %s", granularity, err)
+	}
+
 	return nil
 }
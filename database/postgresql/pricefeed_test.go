@@ -0,0 +1,84 @@
+package postgresql_test
+
+import (
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestUpdateTokenPriceCandles() {
+	err := suite.database.SaveToken(types.NewToken("nom", []types.TokenUnit{
+		types.NewTokenUnit("unom", 0, nil, "nomic"),
+	}))
+	suite.Require().NoError(err)
+
+	start := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+	err = suite.database.SaveTokenPricesHistory([]types.TokenPrice{
+		types.NewTokenPrice("unom", 2, 200, start.Add(30*time.Minute)),
+		types.NewTokenPrice("unom", 1, 100, start),
+		types.NewTokenPrice("unom", 4, 400, start.Add(45*time.Minute)),
+		types.NewTokenPrice("unom", 3, 300, start.Add(59*time.Minute)),
+		types.NewTokenPrice("unom", 5, 500, start.Add(time.Hour)),
+
+		// Outside of the updated range
+		types.NewTokenPrice("unom", 10, 1000, start.Add(2*time.Hour)),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.UpdateTokenPriceCandles(types.StatsGranularityHour, start, start.Add(2*time.Hour))
+	suite.Require().NoError(err)
+
+	type candleRow struct {
+		UnitName  string    `db:"unit_name"`
+		Period    time.Time `db:"period"`
+		Open      float64   `db:"open"`
+		High      float64   `db:"high"`
+		Low       float64   `db:"low"`
+		Close     float64   `db:"close"`
+		MarketCap int64     `db:"market_cap"`
+		Samples   int64     `db:"samples"`
+	}
+
+	getCandles := func(table string) []candleRow {
+		var rows []candleRow
+		err := suite.database.Sqlx.Select(&rows, `SELECT * FROM `+table+` ORDER BY period`)
+		suite.Require().NoError(err)
+		for i := range rows {
+			rows[i].Period = rows[i].Period.UTC()
+		}
+		return rows
+	}
+
+	rows := getCandles("hourly_token_price_candle")
+	suite.Require().Equal([]candleRow{
+		{UnitName: "unom", Period: start, Open: 1, High: 4, Low: 1, Close: 3, MarketCap: 300, Samples: 4},
+		{UnitName: "unom", Period: start.Add(time.Hour), Open: 5, High: 5, Low: 5, Close: 5, MarketCap: 500, Samples: 1},
+	}, rows)
+
+	// Updating the same range again replaces the existing candles
+	err = suite.database.SaveTokenPricesHistory([]types.TokenPrice{
+		types.NewTokenPrice("unom", 6, 600, start.Add(time.Hour+time.Minute)),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.UpdateTokenPriceCandles(types.StatsGranularityHour, start.Add(time.Hour), start.Add(2*time.Hour))
+	suite.Require().NoError(err)
+
+	rows = getCandles("hourly_token_price_candle")
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(candleRow{
+		UnitName: "unom", Period: start.Add(time.Hour), Open: 5, High: 6, Low: 5, Close: 6, MarketCap: 600, Samples: 2,
+	}, rows[1])
+
+	// The daily candles aggregate all the prices of the day
+	err = suite.database.UpdateTokenPriceCandles(types.StatsGranularityDay, start, start.Add(24*time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]candleRow{
+		{UnitName: "unom", Period: start.Truncate(24 * time.Hour), Open: 1, High: 10, Low: 1, Close: 10, MarketCap: 1000, Samples: 7},
+	}, getCandles("daily_token_price_candle"))
+
+	// Invalid granularities are rejected
+	err = suite.database.UpdateTokenPriceCandles("invalid", start, start.Add(time.Hour))
+	suite.Require().Error(err)
+}
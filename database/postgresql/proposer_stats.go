@@ -0,0 +1,97 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetLastProposerStatsDate implements database.Database
+func (db *Database) GetLastProposerStatsDate() (time.Time, error) {
+	stmt := `
+SELECT COALESCE(
+    (SELECT MAX(date) FROM validator_proposer_stats), 
+    (SELECT date_trunc('day', MIN(timestamp)) FROM block)
+)`
+
+	var date sql.NullTime
+	err := db.Sql.QueryRow(stmt).Scan(&date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error while getting last proposer stats date: %s", err)
+	}
+
+	if !date.Valid {
+		return time.Time{}, nil
+	}
+
+	return date.Time, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// UpdateProposerStats implements database.Database
+func (db *Database) UpdateProposerStats(from, to time.Time) error {
+	stmt := `
+WITH blocks AS (
+    SELECT height, proposer_address, date_trunc('day', timestamp) AS date
+    FROM block
+    WHERE timestamp >= $1 AND timestamp < $2
+),
+proposed AS (
+    SELECT date, proposer_address AS validator_address, COUNT(*) AS proposed_blocks
+    FROM blocks
+    WHERE proposer_address IS NOT NULL
+    GROUP BY date, proposer_address
+),
+block_counts AS (
+    SELECT date, COUNT(*) AS blocks
+    FROM blocks
+    GROUP BY date
+),
+gaps AS (
+    SELECT date, validator_address, MAX(height - previous_height - 1) AS longest_gap
+    FROM (
+        SELECT b.date, b.proposer_address AS validator_address, b.height,
+               COALESCE(
+                   LAG(b.height) OVER (PARTITION BY b.proposer_address ORDER BY b.height),
+                   (SELECT MAX(p.height) FROM block p WHERE p.proposer_address = b.proposer_address AND p.height < b.height),
+                   (SELECT MIN(f.height) - 1 FROM block f)
+               ) AS previous_height
+        FROM blocks b
+        WHERE b.proposer_address IS NOT NULL
+    ) AS proposals
+    GROUP BY date, validator_address
+),
+expected AS (
+    SELECT date, validator_address, SUM(share) AS expected_proposals
+    FROM (
+        SELECT b.date, vs.validator_address,
+               vs.voting_power::NUMERIC / NULLIF(SUM(vs.voting_power) OVER (PARTITION BY vs.height), 0) AS share
+        FROM validator_set vs
+                 JOIN blocks b ON b.height = vs.height
+        WHERE vs.voting_power > 0
+    ) AS shares
+    GROUP BY date, validator_address
+)
+INSERT INTO validator_proposer_stats (validator_address, date, proposed_blocks, expected_proposals, longest_gap)
+SELECT COALESCE(e.validator_address, p.validator_address),
+       COALESCE(e.date, p.date),
+       COALESCE(p.proposed_blocks, 0),
+       COALESCE(e.expected_proposals, 0),
+       COALESCE(g.longest_gap, c.blocks)
+FROM expected e
+         FULL OUTER JOIN proposed p ON p.date = e.date AND p.validator_address = e.validator_address
+         LEFT JOIN gaps g ON g.date = COALESCE(e.date, p.date) AND g.validator_address = COALESCE(e.validator_address, p.validator_address)
+         JOIN block_counts c ON c.date = COALESCE(e.date, p.date)
+ON CONFLICT (validator_address, date) DO UPDATE 
+    SET proposed_blocks = excluded.proposed_blocks,
+        expected_proposals = excluded.expected_proposals,
+        longest_gap = excluded.longest_gap`
+
+	_, err := db.Sql.Exec(stmt, from, to)
+	if err != nil {
+		return fmt.Errorf("error while updating proposer stats: %s", err)
+	}
+
+	return nil
+}
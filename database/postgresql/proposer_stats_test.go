@@ -0,0 +1,58 @@
+package postgresql_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestUpdateProposerStats() {
+	date := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	// val1 proposes 3 blocks out of 4, val2 proposes 1, val3 is in the set but never proposes
+	proposers := []string{"val1", "val2", "val1", "val1"}
+	for i, proposer := range proposers {
+		height := int64(i + 1)
+		err := suite.database.SaveBlock(types.NewBlock(height, fmt.Sprintf("HASH%d", height), 0, 0, proposer, date.Add(time.Duration(i)*time.Minute)))
+		suite.Require().NoError(err)
+
+		err = suite.database.SaveValidatorSet([]types.ValidatorSetEntry{
+			types.NewValidatorSetEntry("val1", 50, 0, height),
+			types.NewValidatorSetEntry("val2", 25, 0, height),
+			types.NewValidatorSetEntry("val3", 25, 0, height),
+		})
+		suite.Require().NoError(err)
+	}
+
+	err := suite.database.UpdateProposerStats(date, date.Add(24*time.Hour))
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		ValidatorAddress  string  `db:"validator_address"`
+		ProposedBlocks    int64   `db:"proposed_blocks"`
+		ExpectedProposals float64 `db:"expected_proposals"`
+		LongestGap        int64   `db:"longest_gap"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT validator_address, proposed_blocks, expected_proposals, longest_gap 
+FROM validator_proposer_stats 
+ORDER BY validator_address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+
+	suite.Require().Equal("val1", rows[0].ValidatorAddress)
+	suite.Require().Equal(int64(3), rows[0].ProposedBlocks)
+	suite.Require().InDelta(2, rows[0].ExpectedProposals, 0.0001)
+	suite.Require().Equal(int64(1), rows[0].LongestGap)
+
+	suite.Require().Equal("val2", rows[1].ValidatorAddress)
+	suite.Require().Equal(int64(1), rows[1].ProposedBlocks)
+	suite.Require().InDelta(1, rows[1].ExpectedProposals, 0.0001)
+	suite.Require().Equal(int64(1), rows[1].LongestGap)
+
+	suite.Require().Equal("val3", rows[2].ValidatorAddress)
+	suite.Require().Equal(int64(0), rows[2].ProposedBlocks)
+	suite.Require().InDelta(1, rows[2].ExpectedProposals, 0.0001)
+	suite.Require().Equal(int64(4), rows[2].LongestGap)
+}
@@ -0,0 +1,125 @@
+package postgresql
+
+import (
+	"database/sql"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// replicaLagCheckInterval represents the interval after which the lag of a replica is checked again
+	replicaLagCheckInterval = 5 * time.Second
+
+	// defaultMaxReplicaLag represents the lag used when no custom value is set inside the config
+	defaultMaxReplicaLag = 30 * time.Second
+)
+
+// replica represents a read-only database connection that is used to serve the query-heavy paths
+type replica struct {
+	Sql  *sql.DB
+	Sqlx *sqlx.DB
+
+	mu        sync.Mutex
+	healthy   bool
+	lag       time.Duration
+	checkedAt time.Time
+}
+
+// newReplica opens a new connection to the read replica having the given DSN
+func newReplica(dsn string, maxOpenConnections, maxIdleConnections int) (*replica, error) {
+	replicaDb, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaDb.SetMaxOpenConns(maxOpenConnections)
+	replicaDb.SetMaxIdleConns(maxIdleConnections)
+
+	return &replica{
+		Sql:  replicaDb,
+		Sqlx: sqlx.NewDb(replicaDb, "postgresql"),
+	}, nil
+}
+
+// isUsable tells whether the replica is healthy and its replication lag is below the given max lag.
+// The lag is refreshed at most once every replicaLagCheckInterval.
+func (r *replica) isUsable(maxLag time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) > replicaLagCheckInterval {
+		r.lag, r.healthy = r.queryLag()
+		r.checkedAt = time.Now()
+	}
+
+	return r.healthy && r.lag <= maxLag
+}
+
+// queryLag returns the current replication lag of the replica, and whether it could be read properly
+func (r *replica) queryLag() (time.Duration, bool) {
+	stmt := `
+SELECT CASE 
+    WHEN pg_is_in_recovery() THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) 
+    ELSE 0 
+END`
+
+	var seconds float64
+	err := r.Sql.QueryRow(stmt).Scan(&seconds)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// markUnhealthy marks the replica as not usable until the next lag check
+func (r *replica) markUnhealthy() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.healthy = false
+	r.checkedAt = time.Now()
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// pickReplica returns the next usable replica in a round-robin fashion, or nil if none is available
+func (db *Database) pickReplica() *replica {
+	if len(db.replicas) == 0 {
+		return nil
+	}
+
+	start := atomic.AddUint32(&db.nextReplica, 1)
+	for i := 0; i < len(db.replicas); i++ {
+		r := db.replicas[(int(start)+i)%len(db.replicas)]
+		if r.isUsable(db.maxReplicaLag) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// selectReadOnly runs the given select statement against a usable read replica, if any.
+// If no replica is available, or the query on the replica fails, the primary database is used instead.
+func (db *Database) selectReadOnly(dest interface{}, query string, args ...interface{}) error {
+	if r := db.pickReplica(); r != nil {
+		err := r.Sqlx.Select(dest, query, args...)
+		if err == nil {
+			return nil
+		}
+
+		db.Logger.Error("error while querying read replica, falling back to primary", "err", err)
+		r.markUnhealthy()
+
+		// Reset any partially scanned result before querying the primary
+		value := reflect.ValueOf(dest).Elem()
+		value.Set(reflect.Zero(value.Type()))
+	}
+
+	return db.Sqlx.Select(dest, query, args...)
+}
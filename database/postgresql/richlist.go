@@ -0,0 +1,133 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/forbole/njuno/types"
+)
+
+// SaveRichList implements database.Database
+func (db *Database) SaveRichList(richList types.RichList) error {
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning rich list transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// Replace the previous results, so that denoms no longer held are removed as well
+	for _, table := range []string{"rich_list", "holders_bucket", "holders_distribution"} {
+		_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s`, table))
+		if err != nil {
+			return fmt.Errorf("error while deleting %s rows: %s", table, err)
+		}
+	}
+
+	err = saveRichListEntries(tx, richList.Entries, richList.Height)
+	if err != nil {
+		return err
+	}
+
+	err = saveHoldersBuckets(tx, richList.Buckets, richList.Height)
+	if err != nil {
+		return err
+	}
+
+	err = saveHoldersDistributions(tx, richList.Distributions, richList.Height)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveRichListEntries stores the given rich list entries using the provided transaction
+func saveRichListEntries(tx *sql.Tx, entries []types.RichListEntry, height int64) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO rich_list (denom, rank, address, amount, share, label, height) VALUES `
+	var params []interface{}
+
+	for i, entry := range entries {
+		vi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+
+		var label interface{}
+		if entry.Label != "" {
+			label = entry.Label
+		}
+
+		params = append(params, entry.Denom, entry.Rank, entry.Address, entry.Amount.String(),
+			entry.Share.String(), label, height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing rich list: %s", err)
+	}
+
+	return nil
+}
+
+// saveHoldersBuckets stores the given holders buckets using the provided transaction
+func saveHoldersBuckets(tx *sql.Tx, buckets []types.HoldersBucket, height int64) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO holders_bucket (denom, min_amount, max_amount, holders_count, total_amount, height) VALUES `
+	var params []interface{}
+
+	for i, bucket := range buckets {
+		vi := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6)
+
+		var maxAmount interface{}
+		if bucket.MaxAmount != nil {
+			maxAmount = bucket.MaxAmount.String()
+		}
+
+		params = append(params, bucket.Denom, bucket.MinAmount.String(), maxAmount, bucket.HoldersCount,
+			bucket.TotalAmount.String(), height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing holders buckets: %s", err)
+	}
+
+	return nil
+}
+
+// saveHoldersDistributions stores the given holders distributions using the provided transaction
+func saveHoldersDistributions(tx *sql.Tx, distributions []types.HoldersDistribution, height int64) error {
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO holders_distribution 
+    (denom, holders_count, total_amount, supply, top_1_share, top_10_share, top_100_share, height) 
+VALUES `
+	var params []interface{}
+
+	for i, distribution := range distributions {
+		vi := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8)
+		params = append(params, distribution.Denom, distribution.HoldersCount, distribution.TotalAmount.String(),
+			distribution.Supply.String(), distribution.Top1Share.String(), distribution.Top10Share.String(),
+			distribution.Top100Share.String(), height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing holders distributions: %s", err)
+	}
+
+	return nil
+}
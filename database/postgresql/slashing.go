@@ -0,0 +1,91 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/forbole/njuno/types"
+)
+
+// SaveLightClientAttackEvidence implements database.Database
+func (db *Database) SaveLightClientAttackEvidence(evidence types.LightClientAttackEvidence) error {
+	stmt := `
+INSERT INTO light_client_attack_evidence
+    (hash, conflicting_block_hash, conflicting_block_height, common_height, byzantine_validators,
+     total_voting_power, timestamp, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (hash) DO NOTHING`
+
+	_, err := db.Sql.Exec(stmt,
+		evidence.Hash, evidence.ConflictingBlockHash, evidence.ConflictingBlockHeight, evidence.CommonHeight,
+		pq.Array(evidence.ByzantineValidators), evidence.TotalVotingPower, evidence.Timestamp, evidence.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing light client attack evidence: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorsSlashing implements database.Database
+func (db *Database) SaveValidatorsSlashing(slashing []types.ValidatorSlashing) error {
+	if len(slashing) == 0 {
+		return nil
+	}
+
+	values := ""
+	var params []interface{}
+
+	for i, slash := range slashing {
+		vi := i * 7
+		values += fmt.Sprintf("($%d,$%d::BIGINT,$%d,$%d,$%d::BOOLEAN,$%d,$%d::BIGINT),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+
+		var infractionHeight, power, burnedCoins interface{}
+		if slash.InfractionHeight > 0 {
+			infractionHeight = slash.InfractionHeight
+		}
+		if slash.Power != "" {
+			power = slash.Power
+		}
+		if slash.BurnedCoins != "" {
+			burnedCoins = slash.BurnedCoins
+		}
+
+		params = append(params, slash.ValidatorAddress, infractionHeight, power, slash.Reason, slash.Jailed,
+			burnedCoins, slash.Height)
+	}
+
+	// Link each penalty to the evidence involving the same validator that has been included inside the same block
+	values = values[:len(values)-1]
+	stmt := fmt.Sprintf(`
+INSERT INTO validator_slashing
+    (validator_address, infraction_height, power, reason, jailed, burned_coins,
+     double_sign_evidence_id, light_client_attack_evidence_id, height)
+SELECT s.validator_address, s.infraction_height, s.power, s.reason, s.jailed, s.burned_coins,
+       (SELECT e.id FROM double_sign_evidence e
+        JOIN double_sign_vote v ON v.id = e.vote_a_id
+        WHERE e.height = s.height AND v.validator_address = s.validator_address
+        LIMIT 1),
+       (SELECT l.id FROM light_client_attack_evidence l
+        WHERE l.height = s.height AND s.validator_address = ANY (l.byzantine_validators)
+        LIMIT 1),
+       s.height
+FROM (VALUES %s) AS s (validator_address, infraction_height, power, reason, jailed, burned_coins, height)
+ON CONFLICT (validator_address, reason, height) DO UPDATE
+    SET infraction_height = excluded.infraction_height,
+        power = excluded.power,
+        jailed = excluded.jailed,
+        burned_coins = excluded.burned_coins,
+        double_sign_evidence_id = excluded.double_sign_evidence_id,
+        light_client_attack_evidence_id = excluded.light_client_attack_evidence_id`, values)
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators slashing: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package postgresql_test
+
+import (
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestSaveValidatorsSlashing() {
+	// Validators that are not stored inside the validator table must be accepted
+	err := suite.database.SaveValidatorsSlashing([]types.ValidatorSlashing{
+		types.NewValidatorSlashing("valcons1", 90, "100", "double_sign", true, "10unom", 100),
+		types.NewValidatorSlashing("valcons2", 0, "", types.SlashingReasonJailed, true, "", 100),
+	})
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM validator_slashing`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM validator`)
+	suite.Require().NoError(err)
+	suite.Require().Zero(count)
+}
@@ -1,7 +1,12 @@
 package postgresql
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
 
 	dbtypes "github.com/forbole/njuno/database/types"
 	"github.com/forbole/njuno/types"
@@ -12,9 +17,10 @@ func (db *Database) GetValidatorsDescription() ([]types.ValidatorDescription, er
 	var result []dbtypes.ValidatorDescriptionRow
 	stmt := `SELECT * FROM validator_description`
 
+	// Read from the primary since the result is compared with the latest validators data to detect changes
 	err := db.Sqlx.Select(&result, stmt)
 	if err != nil {
-		return nil, nil
+		return nil, fmt.Errorf("error while getting validators description: %s", err)
 	}
 
 	if len(result) == 0 {
@@ -37,6 +43,66 @@ func (db *Database) GetValidatorsDescription() ([]types.ValidatorDescription, er
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// GetValidatorsCommission implements database.Database
+func (db *Database) GetValidatorsCommission() ([]types.ValidatorCommission, error) {
+	var rows []dbtypes.ValidatorCommissionRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_commission`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators commission: %s", err)
+	}
+
+	var list []types.ValidatorCommission
+	for _, row := range rows {
+		list = append(list, types.NewValidatorCommission(
+			row.OperatorAddress, row.SelfDelegateAddress, row.Commission, dbtypes.ToString(row.MinSelfDelegation), row.Height,
+		))
+	}
+
+	return list, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetValidatorsStatus implements database.Database
+func (db *Database) GetValidatorsStatus() ([]types.ValidatorStatus, error) {
+	var rows []dbtypes.ValidatorStatusRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_status`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators status: %s", err)
+	}
+
+	var list []types.ValidatorStatus
+	for _, row := range rows {
+		list = append(list, types.NewValidatorStatus(
+			row.ConsAddress, row.SelfDelegateAddress, row.InActiveSet, row.Jailed, row.Tombstoned, row.Height,
+		))
+	}
+
+	return list, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetValidatorsVotingPower implements database.Database
+func (db *Database) GetValidatorsVotingPower() ([]types.ValidatorVotingPower, error) {
+	var rows []dbtypes.ValidatorVotingPowerRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_voting_power`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators voting power: %s", err)
+	}
+
+	var list []types.ValidatorVotingPower
+	for _, row := range rows {
+		list = append(list, types.NewValidatorVotingPower(
+			row.ConsAddress, row.SelfDelegateAddress, row.VotingPower, row.Height,
+		))
+	}
+
+	return list, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // SaveCommitSignatures implements database.Database
 func (db *Database) SaveCommitSignatures(signatures []*types.CommitSig) error {
 	if len(signatures) == 0 {
@@ -91,7 +157,9 @@ func (db *Database) saveDoubleSignVote(vote types.DoubleSignVote) (int64, error)
 	stmt := `
 INSERT INTO double_sign_vote 
     (type, height, round, block_id, validator_address, validator_index, signature) 
-VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING RETURNING id`
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT (block_id, validator_address) DO UPDATE SET signature = excluded.signature 
+RETURNING id`
 
 	var id int64
 	err := db.Sql.QueryRow(stmt,
@@ -102,18 +170,50 @@ VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING RETURNING id`
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// GetStakingPool implements database.Database
+func (db *Database) GetStakingPool() (*types.StakingPool, error) {
+	var bondedTokens, notBondedTokens, bondedRatio string
+	var height int64
+	err := db.Sql.QueryRow(`SELECT bonded_tokens, not_bonded_tokens, bonded_ratio, height FROM staking_pool`).
+		Scan(&bondedTokens, &notBondedTokens, &bondedRatio, &height)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking pool: %s", err)
+	}
+
+	bonded, ok := sdk.NewIntFromString(bondedTokens)
+	if !ok {
+		return nil, fmt.Errorf("invalid bonded tokens: %s", bondedTokens)
+	}
+
+	notBonded, ok := sdk.NewIntFromString(notBondedTokens)
+	if !ok {
+		return nil, fmt.Errorf("invalid not bonded tokens: %s", notBondedTokens)
+	}
+
+	ratio, err := sdk.NewDecFromStr(bondedRatio)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bonded ratio: %s", bondedRatio)
+	}
+
+	return types.NewStakingPool(bonded, notBonded, ratio, height), nil
+}
+
 // SaveStakingPool allows to store staking pool values for the given height
 func (db *Database) SaveStakingPool(pool *types.StakingPool) error {
 	stmt := `
-INSERT INTO staking_pool (bonded_tokens, not_bonded_tokens, height) 
-VALUES ($1, $2, $3)
+INSERT INTO staking_pool (bonded_tokens, not_bonded_tokens, bonded_ratio, height) 
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (one_row_id) DO UPDATE 
     SET bonded_tokens = excluded.bonded_tokens, 
         not_bonded_tokens = excluded.not_bonded_tokens, 
+        bonded_ratio = excluded.bonded_ratio,
         height = excluded.height
 WHERE staking_pool.height <= excluded.height`
 
-	_, err := db.Sql.Exec(stmt, pool.BondedTokens.String(), pool.NotBondedTokens.String(), pool.Height)
+	_, err := db.Sql.Exec(stmt, pool.BondedTokens.String(), pool.NotBondedTokens.String(), pool.BondedRatio.String(), pool.Height)
 	if err != nil {
 		return fmt.Errorf("error while storing staking pool: %s", err)
 	}
@@ -123,8 +223,77 @@ WHERE staking_pool.height <= excluded.height`
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// SaveValidatorsInfo implements database.Database
+func (db *Database) SaveValidatorsInfo(info types.ValidatorsInfo) error {
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning validators info transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// The history references the validators, so they need to be stored first
+	err = saveValidators(tx, info.Validators)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorCommissionHistory(tx, info.ChangedCommissions, info.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorDescriptionHistory(tx, info.ChangedDescriptions, info.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorsStatusHistory(tx, info.ChangedStatuses, info.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorsVotingPowerHistory(tx, info.ChangedVotingPowers, info.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorEvents(tx, info.Events)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorCommission(tx, info.Commissions)
+	if err != nil {
+		return fmt.Errorf("error while storing validators commission: %s", err)
+	}
+
+	err = saveValidatorDescription(tx, info.Descriptions)
+	if err != nil {
+		return fmt.Errorf("error while storing validators description: %s", err)
+	}
+
+	err = saveValidatorsStatus(tx, info.Statuses)
+	if err != nil {
+		return err
+	}
+
+	err = saveValidatorsVotingPower(tx, info.VotingPowers)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // SaveValidators implements database.Database
 func (db *Database) SaveValidators(validators []types.Validator) error {
+	return saveValidators(db.Sql, validators)
+}
+
+// saveValidators stores the given validators using the given executor
+func saveValidators(ex sqlExecutor, validators []types.Validator) error {
 	if len(validators) == 0 {
 		return nil
 	}
@@ -144,11 +313,22 @@ func (db *Database) SaveValidators(validators []types.Validator) error {
 
 	validatorQuery = validatorQuery[:len(validatorQuery)-1] // Remove the trailing ","
 	validatorQuery += `ON CONFLICT DO NOTHING`
-	_, err := db.Sql.Exec(validatorQuery, validatorParams...)
+	_, err := ex.Exec(validatorQuery, validatorParams...)
 	if err != nil {
 		return fmt.Errorf("error while storing validator: %s", err)
 	}
 
+	// The stored validators are no longer unknown
+	consensusAddresses := make([]string, len(validators))
+	for i, validator := range validators {
+		consensusAddresses[i] = validator.ConsensusAddr
+	}
+
+	_, err = ex.Exec(`DELETE FROM unknown_validator WHERE consensus_address = ANY($1)`, pq.StringArray(consensusAddresses))
+	if err != nil {
+		return fmt.Errorf("error while removing unknown validators: %s", err)
+	}
+
 	return nil
 }
 
@@ -156,6 +336,15 @@ func (db *Database) SaveValidators(validators []types.Validator) error {
 
 // SaveValidatorCommission saves validators commission in database.
 func (db *Database) SaveValidatorCommission(validatorsCommission []types.ValidatorCommission) error {
+	return saveValidatorCommission(db.Sql, validatorsCommission)
+}
+
+// saveValidatorCommission stores the given validators commission using the given executor
+func saveValidatorCommission(ex sqlExecutor, validatorsCommission []types.ValidatorCommission) error {
+	if len(validatorsCommission) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO validator_commission (validator_address, self_delegate_address, commission, min_self_delegation, height) VALUES `
 
 	var commissionList []interface{}
@@ -178,7 +367,7 @@ ON CONFLICT (self_delegate_address) DO UPDATE
 		min_self_delegation = excluded.min_self_delegation,
 		height = excluded.height
 WHERE validator_commission.height <= excluded.height`
-	_, err := db.Sql.Exec(stmt, commissionList...)
+	_, err := ex.Exec(stmt, commissionList...)
 	return err
 }
 
@@ -186,6 +375,15 @@ WHERE validator_commission.height <= excluded.height`
 
 // SaveValidatorDescription save validators description in database.
 func (db *Database) SaveValidatorDescription(description []types.ValidatorDescription) error {
+	return saveValidatorDescription(db.Sql, description)
+}
+
+// saveValidatorDescription stores the given validators description using the given executor
+func saveValidatorDescription(ex sqlExecutor, description []types.ValidatorDescription) error {
+	if len(description) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO validator_description (validator_address, self_delegate_address, moniker, identity, avatar_url, details, height) VALUES `
 
 	var descriptionList []interface{}
@@ -212,7 +410,7 @@ func (db *Database) SaveValidatorDescription(description []types.ValidatorDescri
         details = excluded.details,
         height = excluded.height
 WHERE validator_description.height <= excluded.height`
-	_, err := db.Sql.Exec(stmt, descriptionList...)
+	_, err := ex.Exec(stmt, descriptionList...)
 	return err
 
 }
@@ -221,6 +419,11 @@ WHERE validator_description.height <= excluded.height`
 
 // SaveValidatorsStatus save latest validator  in database
 func (db *Database) SaveValidatorsStatus(validatorsStatus []types.ValidatorStatus) error {
+	return saveValidatorsStatus(db.Sql, validatorsStatus)
+}
+
+// saveValidatorsStatus stores the given validators status using the given executor
+func saveValidatorsStatus(ex sqlExecutor, validatorsStatus []types.ValidatorStatus) error {
 	if len(validatorsStatus) == 0 {
 		return nil
 	}
@@ -243,7 +446,7 @@ func (db *Database) SaveValidatorsStatus(validatorsStatus []types.ValidatorStatu
 		    tombstoned = excluded.tombstoned,
 		    height = excluded.height
 	WHERE validator_status.height <= excluded.height`
-	_, err := db.Sql.Exec(validatorStatusStmt, validatorStatusParams...)
+	_, err := ex.Exec(validatorStatusStmt, validatorStatusParams...)
 	if err != nil {
 		return fmt.Errorf("error while stroring validators status: %s", err)
 	}
@@ -255,6 +458,11 @@ func (db *Database) SaveValidatorsStatus(validatorsStatus []types.ValidatorStatu
 
 // SaveValidatorsVotingPower saves the given validator voting powers.
 func (db *Database) SaveValidatorsVotingPower(entries []types.ValidatorVotingPower) error {
+	return saveValidatorsVotingPower(db.Sql, entries)
+}
+
+// saveValidatorsVotingPower stores the given validators voting power using the given executor
+func saveValidatorsVotingPower(ex sqlExecutor, entries []types.ValidatorVotingPower) error {
 	if len(entries) == 0 {
 		return nil
 	}
@@ -276,7 +484,7 @@ ON CONFLICT (self_delegate_address) DO UPDATE
 		height = excluded.height
 WHERE validator_voting_power.height <= excluded.height`
 
-	_, err := db.Sql.Exec(stmt, params...)
+	_, err := ex.Exec(stmt, params...)
 	if err != nil {
 		return fmt.Errorf("error while storing validators voting power: %s", err)
 	}
@@ -284,3 +492,154 @@ WHERE validator_voting_power.height <= excluded.height`
 	return nil
 
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// saveValidatorCommissionHistory stores the given validators commission changes using the given executor
+func saveValidatorCommissionHistory(ex sqlExecutor, data []types.ValidatorCommission, timestamp time.Time) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_commission_history (self_delegate_address, validator_address, commission, min_self_delegation, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, entry := range data {
+		pi := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6)
+		params = append(params, entry.SelfDelegateAddress, entry.ValAddress, entry.Commission,
+			entry.MinSelfDelegation, entry.Height, timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := ex.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators commission history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// saveValidatorDescriptionHistory stores the given validators description changes using the given executor
+func saveValidatorDescriptionHistory(ex sqlExecutor, description []types.ValidatorDescription, timestamp time.Time) error {
+	if len(description) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_description_history (self_delegate_address, validator_address, moniker, identity, avatar_url, details, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, desc := range description {
+		pi := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6, pi+7, pi+8)
+		params = append(params,
+			desc.SelfDelegateAddress,
+			desc.OperatorAddress,
+			dbtypes.ToNullString(desc.Moniker),
+			dbtypes.ToNullString(desc.Identity),
+			dbtypes.ToNullString(desc.AvatarURL),
+			dbtypes.ToNullString(desc.Description),
+			desc.Height,
+			timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := ex.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators description history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// saveValidatorsStatusHistory stores the given validators status changes using the given executor
+func saveValidatorsStatusHistory(ex sqlExecutor, validatorsStatus []types.ValidatorStatus, timestamp time.Time) error {
+	if len(validatorsStatus) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_status_history (self_delegate_address, validator_address, in_active_set, jailed, tombstoned, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, status := range validatorsStatus {
+		pi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6, pi+7)
+		params = append(params, status.SelfDelegateAddress, status.ConsensusAddress, status.InActiveSet,
+			status.Jailed, status.Tombstoned, status.Height, timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := ex.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators status history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// saveValidatorsVotingPowerHistory stores the given validators voting power changes using the given executor
+func saveValidatorsVotingPowerHistory(ex sqlExecutor, entries []types.ValidatorVotingPower, timestamp time.Time) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_voting_power_history (self_delegate_address, validator_address, voting_power, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, entry := range entries {
+		pi := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5)
+		params = append(params, entry.SelfDelegateAddress, entry.ConsensusAddress, entry.VotingPower, entry.Height, timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := ex.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators voting power history: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// saveValidatorEvents stores the given validator events using the given executor
+func saveValidatorEvents(ex sqlExecutor, events []types.ValidatorEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_event (self_delegate_address, validator_address, type, old_value, new_value, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, event := range events {
+		pi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6, pi+7)
+		params = append(params, event.SelfDelegateAddress, event.ValidatorAddress, event.Type,
+			dbtypes.ToNullString(event.OldValue), dbtypes.ToNullString(event.NewValue), event.Height, event.Timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := ex.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator events: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package postgresql_test
+
+import (
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestSaveValidatorsInfo() {
+	timestamp := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+	info := types.ValidatorsInfo{
+		Validators:   []types.Validator{types.NewValidator("nomicvalcons1a", "nomic1a", 10)},
+		Commissions:  []types.ValidatorCommission{types.NewValidatorCommission("nomicvalcons1a", "nomic1a", "0.05", "1", 10)},
+		Statuses:     []types.ValidatorStatus{types.NewValidatorStatus("nomicvalcons1a", "nomic1a", "true", "false", "false", 10)},
+		VotingPowers: []types.ValidatorVotingPower{types.NewValidatorVotingPower("nomicvalcons1a", "nomic1a", "100", 10)},
+
+		ChangedCommissions:  []types.ValidatorCommission{types.NewValidatorCommission("nomicvalcons1a", "nomic1a", "0.05", "1", 10)},
+		ChangedStatuses:     []types.ValidatorStatus{types.NewValidatorStatus("nomicvalcons1a", "nomic1a", "true", "false", "false", 10)},
+		ChangedVotingPowers: []types.ValidatorVotingPower{types.NewValidatorVotingPower("nomicvalcons1a", "nomic1a", "100", 10)},
+		Events: []types.ValidatorEvent{
+			types.NewValidatorEvent("nomic1a", "nomicvalcons1a", types.ValidatorEventJailed, "true", "false", 10, timestamp),
+		},
+		Timestamp: timestamp,
+	}
+
+	// The history of a new validator is stored along with the validator itself
+	err := suite.database.SaveValidatorsInfo(info)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM validator_commission_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM validator_event`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	// A failure does not leave the history out of sync with the current values
+	failing := info
+	failing.Commissions = []types.ValidatorCommission{types.NewValidatorCommission("nomicvalcons1a", "nomic1a", "0.10", "1", 20)}
+	failing.ChangedCommissions = failing.Commissions
+	failing.ChangedVotingPowers = []types.ValidatorVotingPower{types.NewValidatorVotingPower("nomicvalcons1b", "nomic1b", "100", 20)}
+
+	err = suite.database.SaveValidatorsInfo(failing)
+	suite.Require().Error(err)
+
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM validator_commission_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	var commission string
+	err = suite.database.Sqlx.Get(&commission, `SELECT commission FROM validator_commission`)
+	suite.Require().NoError(err)
+	suite.Require().Equal("0.05", commission)
+}
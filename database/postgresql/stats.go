@@ -0,0 +1,131 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+// getChainStatsTable returns the name of the table containing the chain statistics having the given granularity
+func getChainStatsTable(granularity types.StatsGranularity) (string, error) {
+	switch granularity {
+	case types.StatsGranularityHour:
+		return "hourly_chain_stats", nil
+	case types.StatsGranularityDay:
+		return "daily_chain_stats", nil
+	default:
+		return "", fmt.Errorf("invalid chain stats granularity: %s", granularity)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetLastChainStatsPeriod implements database.Database
+func (db *Database) GetLastChainStatsPeriod(granularity types.StatsGranularity) (time.Time, error) {
+	table, err := getChainStatsTable(granularity)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	stmt := fmt.Sprintf(`
+SELECT COALESCE(
+    (SELECT MAX(period) FROM %[1]s), 
+    (SELECT date_trunc('%[2]s', MIN(timestamp)) FROM block)
+)`, table, granularity)
+
+	var period sql.NullTime
+	err = db.Sql.QueryRow(stmt).Scan(&period)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error while getting last %s chain stats period: %s", granularity, err)
+	}
+
+	if !period.Valid {
+		return time.Time{}, nil
+	}
+
+	return period.Time, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// UpdateChainStats implements database.Database
+func (db *Database) UpdateChainStats(granularity types.StatsGranularity, from, to time.Time) error {
+	table, err := getChainStatsTable(granularity)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`
+WITH blocks AS (
+    SELECT date_trunc('%[2]s', timestamp) AS period,
+           COUNT(*) AS blocks,
+           CASE WHEN COUNT(*) > 1 
+               THEN EXTRACT(EPOCH FROM MAX(timestamp) - MIN(timestamp)) / (COUNT(*) - 1) 
+               ELSE 0 
+           END AS average_block_time,
+           MIN(height) AS first_height,
+           MAX(height) AS last_height
+    FROM block
+    WHERE timestamp >= $1 AND timestamp < $2
+    GROUP BY 1
+), txs AS (
+    SELECT date_trunc('%[2]s', block.timestamp) AS period,
+           COUNT(*) AS txs,
+           COALESCE(SUM(NULLIF(transaction.gas, '')::NUMERIC), 0) AS total_gas
+    FROM transaction
+    JOIN block ON block.height = transaction.height
+    WHERE block.timestamp >= $1 AND block.timestamp < $2
+    GROUP BY 1
+), fees AS (
+    SELECT period, array_agg(ROW(denom, amount::TEXT)::COIN) AS fees
+    FROM (
+        SELECT date_trunc('%[2]s', block.timestamp) AS period, fee.denom, SUM(fee.amount::NUMERIC) AS amount
+        FROM transaction
+        JOIN block ON block.height = transaction.height
+        CROSS JOIN LATERAL unnest(transaction.fee) AS fee
+        WHERE block.timestamp >= $1 AND block.timestamp < $2
+        GROUP BY 1, 2
+    ) AS period_fees
+    GROUP BY period
+), signers AS (
+    SELECT date_trunc('%[2]s', block.timestamp) AS period,
+           COUNT(DISTINCT signer) AS unique_signers
+    FROM transaction
+    JOIN block ON block.height = transaction.height
+    CROSS JOIN LATERAL unnest(transaction.signers) AS signer
+    WHERE block.timestamp >= $1 AND block.timestamp < $2
+    GROUP BY 1
+)
+INSERT INTO %[1]s (period, blocks, txs, total_gas, fees, unique_signers, average_block_time, first_height, last_height)
+SELECT blocks.period,
+       blocks.blocks,
+       COALESCE(txs.txs, 0),
+       COALESCE(txs.total_gas, 0),
+       COALESCE(fees.fees, '{}'),
+       COALESCE(signers.unique_signers, 0),
+       blocks.average_block_time,
+       blocks.first_height,
+       blocks.last_height
+FROM blocks
+LEFT JOIN txs ON txs.period = blocks.period
+LEFT JOIN fees ON fees.period = blocks.period
+LEFT JOIN signers ON signers.period = blocks.period
+ON CONFLICT (period) DO UPDATE 
+    SET blocks = excluded.blocks,
+        txs = excluded.txs,
+        total_gas = excluded.total_gas,
+        fees = excluded.fees,
+        unique_signers = excluded.unique_signers,
+        average_block_time = excluded.average_block_time,
+        first_height = excluded.first_height,
+        last_height = excluded.last_height`, table, granularity)
+
+	_, err = db.Sql.Exec(stmt, from.UTC(), to.UTC())
+	if err != nil {
+		return fmt.Errorf("error while storing %s chain stats: %s", granularity, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgresql_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// saveTestBlocks stores a block at each of the given times, starting from height 1
+func (suite *DbTestSuite) saveTestBlocks(times ...time.Time) {
+	for i, timestamp := range times {
+		height := int64(i + 1)
+		err := suite.database.SaveBlock(types.NewBlock(height, fmt.Sprintf("HASH%d", height), 0, 0, "proposer", timestamp))
+		suite.Require().NoError(err)
+	}
+}
+
+// saveTestTx stores a transaction at the given height containing one message for each given signer
+func (suite *DbTestSuite) saveTestTx(hash string, height int64, fee sdk.Coins, gas string, signers ...string) {
+	msgs := make([]types.TxMsg, len(signers))
+	for i, signer := range signers {
+		msgs[i] = types.TxMsg{Type: "nomic/MsgDelegate", Value: types.TxMsgValue{DelegatorAddress: signer}}
+	}
+
+	tx := types.NewTxResponse(types.TxFee{Amount: fee, Gas: gas}, "", msgs, nil, hash, height)
+	suite.Require().NoError(suite.database.SaveTx(tx))
+}
+
+func (suite *DbTestSuite) TestUpdateChainStats() {
+	start := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+	suite.saveTestBlocks(start, start.Add(10*time.Second), start.Add(30*time.Second), start.Add(time.Hour))
+
+	suite.saveTestTx("TX1", 1, sdk.NewCoins(sdk.NewInt64Coin("unom", 10)), "100", "nomic1a")
+	suite.saveTestTx("TX2", 2, sdk.NewCoins(sdk.NewInt64Coin("unom", 5)), "50", "nomic1a", "nomic1b")
+	suite.saveTestTx("TX3", 4, sdk.NewCoins(sdk.NewInt64Coin("unom", 1)), "", "nomic1c")
+
+	err := suite.database.UpdateChainStats(types.StatsGranularityHour, start, start.Add(2*time.Hour))
+	suite.Require().NoError(err)
+
+	type statsRow struct {
+		Period           time.Time `db:"period"`
+		Blocks           int64     `db:"blocks"`
+		Txs              int64     `db:"txs"`
+		TotalGas         int64     `db:"total_gas"`
+		Fees             string    `db:"fees"`
+		UniqueSigners    int64     `db:"unique_signers"`
+		AverageBlockTime float64   `db:"average_block_time"`
+		FirstHeight      int64     `db:"first_height"`
+		LastHeight       int64     `db:"last_height"`
+	}
+
+	var rows []statsRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT period, blocks, txs, total_gas, fees::TEXT AS fees, unique_signers, average_block_time, first_height, last_height 
+FROM hourly_chain_stats ORDER BY period`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().True(rows[0].Period.Equal(start))
+	suite.Require().Equal(int64(3), rows[0].Blocks)
+	suite.Require().Equal(int64(2), rows[0].Txs)
+	suite.Require().Equal(int64(150), rows[0].TotalGas)
+	suite.Require().Equal(`{"(unom,15)"}`, rows[0].Fees)
+	suite.Require().Equal(int64(2), rows[0].UniqueSigners)
+	suite.Require().Equal(15.0, rows[0].AverageBlockTime)
+	suite.Require().Equal(int64(1), rows[0].FirstHeight)
+	suite.Require().Equal(int64(3), rows[0].LastHeight)
+
+	suite.Require().True(rows[1].Period.Equal(start.Add(time.Hour)))
+	suite.Require().Equal(int64(1), rows[1].Blocks)
+	suite.Require().Equal(int64(1), rows[1].Txs)
+	suite.Require().Equal(int64(0), rows[1].TotalGas)
+	suite.Require().Equal(int64(1), rows[1].UniqueSigners)
+	suite.Require().Equal(0.0, rows[1].AverageBlockTime)
+
+	// Re-running the aggregation must not change the stored stats
+	err = suite.database.UpdateChainStats(types.StatsGranularityHour, start, start.Add(2*time.Hour))
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM hourly_chain_stats`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
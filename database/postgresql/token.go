@@ -8,6 +8,36 @@ import (
 	"github.com/lib/pq"
 )
 
+// GetToken implements database.Database
+func (db *Database) GetToken(denom string) (*types.Token, error) {
+	query := `
+SELECT * FROM token_unit 
+WHERE token_name = (
+    SELECT token_name FROM token_unit WHERE denom = $1 OR $1 = ANY (aliases) LIMIT 1
+)
+ORDER BY exponent`
+
+	var dbUnits []dbtypes.TokenUnitRow
+	err := db.Sqlx.Select(&dbUnits, query, denom)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting token of %s: %s", denom, err)
+	}
+
+	if len(dbUnits) == 0 {
+		return nil, nil
+	}
+
+	units := make([]types.TokenUnit, len(dbUnits))
+	for i, unit := range dbUnits {
+		units[i] = types.NewTokenUnit(unit.Denom, unit.Exponent, unit.Aliases, unit.PriceID.String)
+	}
+
+	token := types.NewToken(dbUnits[0].TokenName, units)
+	return &token, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // SaveToken allows to save the given token details
 func (db *Database) SaveToken(token types.Token) error {
 	query := `INSERT INTO token (name) VALUES ($1) ON CONFLICT DO NOTHING`
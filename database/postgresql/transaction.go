@@ -30,19 +30,20 @@ func (db *Database) SaveTx(tx types.TxResponse) error {
 func (db *Database) saveTxInsidePartition(tx types.TxResponse, partitionID int64) error {
 	sqlStatement := `
 INSERT INTO transaction 
-(hash, height, memo, signatures, fee, gas, partition_id) 
-VALUES ($1, $2, $3, $4, $5, $6, $7) 
+(hash, height, memo, signatures, signers, fee, gas, partition_id) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
 ON CONFLICT (hash, partition_id) DO UPDATE 
 	SET height = excluded.height, 
 		memo = excluded.memo, 
 		signatures = excluded.signatures, 
+		signers = excluded.signers, 
 		fee = excluded.fee,
 		gas = excluded.gas`
 
 	if tx.Height != 0 {
 		_, err := db.Sql.Exec(sqlStatement,
 			tx.Hash, tx.Height, tx.Memo, pq.Array(dbtypes.NewDBSignatures(tx.Signatures)),
-			pq.Array(dbtypes.NewDbCoins(tx.Fee.Amount)), tx.Fee.Gas, partitionID)
+			pq.StringArray(tx.GetSigners()), pq.Array(dbtypes.NewDbCoins(tx.Fee.Amount)), tx.Fee.Gas, partitionID)
 		if err != nil {
 			return fmt.Errorf("error while storing transaction with hash %s : %s", tx.Hash, err)
 		}
@@ -0,0 +1,232 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/forbole/njuno/types"
+)
+
+// uptimeDelta contains the changes that should be applied to the uptime of a single validator
+type uptimeDelta struct {
+	windowSigned     int64
+	windowMissed     int64
+	signed           int64
+	missed           int64
+	lastSignedHeight int64
+	height           int64
+}
+
+// SaveValidatorsSignatures implements database.Database
+func (db *Database) SaveValidatorsSignatures(signatures []types.ValidatorSignature, windowSize int64) error {
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning uptime transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// Skip the heights that have already been processed, so that processing the same height twice
+	// or backfilling heights out of order never counts the same signatures more than once
+	signatures, err = filterUnprocessedSignatures(tx, signatures)
+	if err != nil {
+		return err
+	}
+
+	if len(signatures) == 0 {
+		return tx.Commit()
+	}
+
+	var latestHeight int64
+	err = tx.QueryRow(`SELECT COALESCE(MAX(height), 0) FROM validator_signing_window`).Scan(&latestHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting latest signing window height: %s", err)
+	}
+
+	deltas := map[string]*uptimeDelta{}
+	getDelta := func(address string) *uptimeDelta {
+		if _, ok := deltas[address]; !ok {
+			deltas[address] = &uptimeDelta{}
+		}
+		return deltas[address]
+	}
+
+	// Store the signatures that fall inside the window
+	var inWindow []types.ValidatorSignature
+	for _, signature := range signatures {
+		if signature.Height > latestHeight-windowSize {
+			inWindow = append(inWindow, signature)
+		} else {
+			// The height is older than the window, so only the lifetime counters are updated
+			delta := getDelta(signature.ValidatorAddress)
+			delta.addSignature(signature.Height, signature.Signed)
+		}
+	}
+
+	if len(inWindow) > 0 {
+		stmt := `INSERT INTO validator_signing_window (validator_address, height, signed) VALUES `
+		var params []interface{}
+		for i, signature := range inWindow {
+			pi := i * 3
+			stmt += fmt.Sprintf("($%d,$%d,$%d),", pi+1, pi+2, pi+3)
+			params = append(params, signature.ValidatorAddress, signature.Height, signature.Signed)
+		}
+		stmt = stmt[:len(stmt)-1]
+		stmt += ` ON CONFLICT DO NOTHING RETURNING validator_address, height, signed`
+
+		rows, err := tx.Query(stmt, params...)
+		if err != nil {
+			return fmt.Errorf("error while storing validators signing window: %s", err)
+		}
+
+		err = scanSignatures(rows, func(address string, height int64, signed bool) {
+			delta := getDelta(address)
+			delta.addSignature(height, signed)
+			if signed {
+				delta.windowSigned++
+			} else {
+				delta.windowMissed++
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, signature := range inWindow {
+			if signature.Height > latestHeight {
+				latestHeight = signature.Height
+			}
+		}
+	}
+
+	// Remove the signatures that are now outside the window
+	rows, err := tx.Query(`
+DELETE FROM validator_signing_window WHERE height <= $1 
+RETURNING validator_address, height, signed`, latestHeight-windowSize)
+	if err != nil {
+		return fmt.Errorf("error while pruning validators signing window: %s", err)
+	}
+
+	err = scanSignatures(rows, func(address string, _ int64, signed bool) {
+		delta := getDelta(address)
+		if signed {
+			delta.windowSigned--
+		} else {
+			delta.windowMissed--
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	stmt := `
+INSERT INTO validator_uptime 
+    (validator_address, window_size, window_signed_blocks, window_missed_blocks, signed_blocks, missed_blocks, last_signed_height, height) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (validator_address) DO UPDATE 
+    SET window_size = excluded.window_size,
+        window_signed_blocks = validator_uptime.window_signed_blocks + excluded.window_signed_blocks,
+        window_missed_blocks = validator_uptime.window_missed_blocks + excluded.window_missed_blocks,
+        signed_blocks = validator_uptime.signed_blocks + excluded.signed_blocks,
+        missed_blocks = validator_uptime.missed_blocks + excluded.missed_blocks,
+        last_signed_height = GREATEST(validator_uptime.last_signed_height, excluded.last_signed_height),
+        height = GREATEST(validator_uptime.height, excluded.height)`
+
+	for address, delta := range deltas {
+		var lastSignedHeight sql.NullInt64
+		if delta.lastSignedHeight > 0 {
+			lastSignedHeight = sql.NullInt64{Int64: delta.lastSignedHeight, Valid: true}
+		}
+
+		_, err = tx.Exec(stmt, address, windowSize, delta.windowSigned, delta.windowMissed,
+			delta.signed, delta.missed, lastSignedHeight, delta.height)
+		if err != nil {
+			return fmt.Errorf("error while storing validator uptime: %s", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// filterUnprocessedSignatures marks the heights of the given signatures as processed,
+// returning only the signatures of the heights that had not been processed yet
+func filterUnprocessedSignatures(tx *sql.Tx, signatures []types.ValidatorSignature) ([]types.ValidatorSignature, error) {
+	stmt := `INSERT INTO validator_uptime_processed_height (height) VALUES `
+	var params []interface{}
+	seen := map[int64]bool{}
+	for _, signature := range signatures {
+		if seen[signature.Height] {
+			continue
+		}
+		seen[signature.Height] = true
+
+		params = append(params, signature.Height)
+		stmt += fmt.Sprintf("($%d),", len(params))
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING RETURNING height`
+
+	rows, err := tx.Query(stmt, params...)
+	if err != nil {
+		return nil, fmt.Errorf("error while storing uptime processed heights: %s", err)
+	}
+	defer rows.Close()
+
+	unprocessed := map[int64]bool{}
+	for rows.Next() {
+		var height int64
+		err = rows.Scan(&height)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning uptime processed height: %s", err)
+		}
+		unprocessed[height] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var filtered []types.ValidatorSignature
+	for _, signature := range signatures {
+		if unprocessed[signature.Height] {
+			filtered = append(filtered, signature)
+		}
+	}
+	return filtered, nil
+}
+
+// addSignature updates the lifetime counters of the delta with the given signature
+func (d *uptimeDelta) addSignature(height int64, signed bool) {
+	if signed {
+		d.signed++
+		if height > d.lastSignedHeight {
+			d.lastSignedHeight = height
+		}
+	} else {
+		d.missed++
+	}
+
+	if height > d.height {
+		d.height = height
+	}
+}
+
+// scanSignatures calls the given function for each validator_address, height and signed row, closing the rows
+func scanSignatures(rows *sql.Rows, fn func(address string, height int64, signed bool)) error {
+	defer rows.Close()
+
+	for rows.Next() {
+		var address string
+		var height int64
+		var signed bool
+		err := rows.Scan(&address, &height, &signed)
+		if err != nil {
+			return fmt.Errorf("error while scanning validator signature: %s", err)
+		}
+		fn(address, height, signed)
+	}
+
+	return rows.Err()
+}
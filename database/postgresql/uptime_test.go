@@ -0,0 +1,49 @@
+package postgresql_test
+
+import (
+	"github.com/forbole/njuno/types"
+)
+
+type uptimeRow struct {
+	WindowSigned int64 `db:"window_signed_blocks"`
+	WindowMissed int64 `db:"window_missed_blocks"`
+	Signed       int64 `db:"signed_blocks"`
+	Missed       int64 `db:"missed_blocks"`
+}
+
+func (suite *DbTestSuite) getUptime(address string) uptimeRow {
+	var row uptimeRow
+	err := suite.database.Sqlx.Get(&row, `
+SELECT window_signed_blocks, window_missed_blocks, signed_blocks, missed_blocks 
+FROM validator_uptime WHERE validator_address = $1`, address)
+	suite.Require().NoError(err)
+	return row
+}
+
+func (suite *DbTestSuite) TestSaveValidatorsSignatures_Idempotent() {
+	windowSize := int64(3)
+	save := func(height int64, signed bool) {
+		err := suite.database.SaveValidatorsSignatures([]types.ValidatorSignature{
+			types.NewValidatorSignature("nomicvalcons1a", height, signed),
+		}, windowSize)
+		suite.Require().NoError(err)
+	}
+
+	for height := int64(10); height <= 14; height++ {
+		save(height, height != 12)
+	}
+	expected := uptimeRow{WindowSigned: 2, WindowMissed: 1, Signed: 4, Missed: 1}
+	suite.Require().Equal(expected, suite.getUptime("nomicvalcons1a"))
+
+	// Processing again heights both inside and outside the window must not change the counters
+	save(13, true)
+	save(11, true)
+	save(12, true)
+	suite.Require().Equal(expected, suite.getUptime("nomicvalcons1a"))
+
+	// Backfilling an older height outside the window only updates the lifetime counters, once
+	save(5, false)
+	save(5, false)
+	expected.Missed++
+	suite.Require().Equal(expected, suite.getUptime("nomicvalcons1a"))
+}
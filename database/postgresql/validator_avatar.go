@@ -0,0 +1,57 @@
+package postgresql
+
+import (
+	"fmt"
+
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/types"
+)
+
+// GetValidatorsAvatars implements database.Database
+func (db *Database) GetValidatorsAvatars() ([]types.ValidatorAvatar, error) {
+	var rows []dbtypes.ValidatorAvatarRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_avatar`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators avatars: %s", err)
+	}
+
+	avatars := make([]types.ValidatorAvatar, len(rows))
+	for i, row := range rows {
+		avatars[i] = types.NewValidatorAvatar(row.Identity, dbtypes.ToString(row.AvatarURL), row.UpdatedAt)
+	}
+
+	return avatars, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorAvatar implements database.Database
+func (db *Database) SaveValidatorAvatar(avatar types.ValidatorAvatar) error {
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning validator avatar transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	stmt := `
+INSERT INTO validator_avatar (identity, avatar_url, updated_at) 
+VALUES ($1, $2, $3)
+ON CONFLICT (identity) DO UPDATE 
+    SET avatar_url = excluded.avatar_url,
+        updated_at = excluded.updated_at`
+	_, err = tx.Exec(stmt, avatar.Identity, dbtypes.ToNullString(avatar.AvatarURL), avatar.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error while storing validator avatar: %s", err)
+	}
+
+	stmt = `
+UPDATE validator_description 
+SET avatar_url = $2 
+WHERE identity = $1 AND avatar_url IS DISTINCT FROM $2`
+	_, err = tx.Exec(stmt, avatar.Identity, dbtypes.ToNullString(avatar.AvatarURL))
+	if err != nil {
+		return fmt.Errorf("error while updating validators avatar url: %s", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,72 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/forbole/njuno/types"
+)
+
+// HasValidatorSetBefore implements database.Database
+func (db *Database) HasValidatorSetBefore(height int64) (bool, error) {
+	var exists bool
+	err := db.Sql.QueryRow(`SELECT EXISTS (SELECT 1 FROM validator_set WHERE height < $1)`, height).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error while checking validator set before height %d: %s", height, err)
+	}
+	return exists, nil
+}
+
+// SaveValidatorSet implements database.Database
+func (db *Database) SaveValidatorSet(entries []types.ValidatorSetEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning validator set transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	stmt := `INSERT INTO validator_set (validator_address, voting_power, proposer_priority, height) VALUES `
+	var params []interface{}
+
+	addresses := make([]string, len(entries))
+	heights := make([]int64, len(entries))
+	for i, entry := range entries {
+		si := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", si+1, si+2, si+3, si+4)
+		params = append(params, entry.ValidatorAddress, entry.VotingPower, entry.ProposerPriority, entry.Height)
+
+		addresses[i] = entry.ValidatorAddress
+		heights[i] = entry.Height
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (validator_address, height) DO UPDATE 
+    SET voting_power = excluded.voting_power,
+        proposer_priority = excluded.proposer_priority`
+	_, err = tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator set: %s", err)
+	}
+
+	// Register the validators that are not stored yet, keeping the lowest height at which they have been seen
+	_, err = tx.Exec(`
+INSERT INTO unknown_validator (consensus_address, height)
+SELECT entry.address, MIN(entry.height)
+FROM unnest($1::TEXT[], $2::BIGINT[]) AS entry(address, height)
+WHERE NOT EXISTS (SELECT 1 FROM validator WHERE validator.consensus_address = entry.address)
+GROUP BY entry.address
+ON CONFLICT (consensus_address) DO UPDATE 
+    SET height = LEAST(unknown_validator.height, excluded.height)`,
+		pq.StringArray(addresses), pq.Int64Array(heights))
+	if err != nil {
+		return fmt.Errorf("error while registering unknown validators: %s", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,70 @@
+package postgresql_test
+
+import (
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestSaveValidatorSet() {
+	// Validators that are not stored inside the validator table must be accepted as well
+	err := suite.database.SaveValidatorSet([]types.ValidatorSetEntry{
+		types.NewValidatorSetEntry("valcons1", 100, 10, 10),
+		types.NewValidatorSetEntry("valcons2", 200, -10, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same height again must update the existing rows
+	err = suite.database.SaveValidatorSet([]types.ValidatorSetEntry{
+		types.NewValidatorSetEntry("valcons1", 150, 5, 10),
+	})
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		ValidatorAddress string `db:"validator_address"`
+		VotingPower      int64  `db:"voting_power"`
+		ProposerPriority int64  `db:"proposer_priority"`
+		Height           int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_set ORDER BY validator_address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal("valcons1", rows[0].ValidatorAddress)
+	suite.Require().Equal(int64(150), rows[0].VotingPower)
+	suite.Require().Equal(int64(5), rows[0].ProposerPriority)
+	suite.Require().Equal(int64(200), rows[1].VotingPower)
+
+	// The validators that are not stored are registered as unknown, keeping the lowest height
+	err = suite.database.SaveValidatorSet([]types.ValidatorSetEntry{
+		types.NewValidatorSetEntry("valcons1", 150, 5, 5),
+		types.NewValidatorSetEntry("valcons2", 200, -10, 20),
+	})
+	suite.Require().NoError(err)
+
+	var unknown []struct {
+		ConsensusAddress string `db:"consensus_address"`
+		Height           int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&unknown, `SELECT * FROM unknown_validator ORDER BY consensus_address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(unknown, 2)
+	suite.Require().Equal("valcons1", unknown[0].ConsensusAddress)
+	suite.Require().Equal(int64(5), unknown[0].Height)
+	suite.Require().Equal("valcons2", unknown[1].ConsensusAddress)
+	suite.Require().Equal(int64(10), unknown[1].Height)
+
+	hasBaseline, err := suite.database.HasValidatorSetBefore(5)
+	suite.Require().NoError(err)
+	suite.Require().False(hasBaseline)
+
+	hasBaseline, err = suite.database.HasValidatorSetBefore(6)
+	suite.Require().NoError(err)
+	suite.Require().True(hasBaseline)
+
+	// Storing a validator removes it from the unknown ones
+	err = suite.database.SaveValidators([]types.Validator{types.NewValidator("valcons1", "nomic1a", 30)})
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT COUNT(*) FROM unknown_validator`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+}
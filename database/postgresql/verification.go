@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/forbole/njuno/database"
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/types"
+)
+
+// type check to ensure interface is properly implemented
+var _ database.VerificationDb = &Database{}
+
+// DeleteReprocessedData implements database.VerificationDb
+func (db *Database) DeleteReprocessedData(height int64) error {
+	tx, err := db.Sql.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning delete transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`DELETE FROM transaction WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting transactions of height %d: %s", height, err)
+	}
+
+	// The commit signatures contained inside the block are the ones of the previous height
+	_, err = tx.Exec(`DELETE FROM pre_commit WHERE height = $1`, height-1)
+	if err != nil {
+		return fmt.Errorf("error while deleting pre commits of height %d: %s", height-1, err)
+	}
+
+	return tx.Commit()
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetLastVerifiedHeight implements database.VerificationDb
+func (db *Database) GetLastVerifiedHeight() (int64, error) {
+	var height int64
+	err := db.Sql.QueryRow(`SELECT COALESCE(MAX(last_verified_height), 0) FROM verification_progress`).Scan(&height)
+	return height, err
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveVerificationIssues implements database.VerificationDb
+func (db *Database) SaveVerificationIssues(issues []types.VerificationIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO verification_issue (height, type, expected, actual, reenqueued, timestamp) VALUES `
+
+	var params []interface{}
+	for i, issue := range issues {
+		ii := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", ii+1, ii+2, ii+3, ii+4, ii+5, ii+6)
+		params = append(params, issue.Height, issue.Type,
+			dbtypes.ToNullString(issue.Expected), dbtypes.ToNullString(issue.Actual),
+			issue.Reenqueued, issue.Timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (height, type) DO UPDATE 
+    SET expected = excluded.expected,
+        actual = excluded.actual,
+        reenqueued = excluded.reenqueued,
+        timestamp = excluded.timestamp`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing verification issues: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// StoreLastVerifiedHeight implements database.VerificationDb
+func (db *Database) StoreLastVerifiedHeight(height int64) error {
+	stmt := `
+INSERT INTO verification_progress (last_verified_height) 
+VALUES ($1)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET last_verified_height = excluded.last_verified_height`
+
+	_, err := db.Sql.Exec(stmt, height)
+	if err != nil {
+		return fmt.Errorf("error while storing last verified height: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package postgresql_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+func (suite *DbTestSuite) TestDeleteReprocessedData() {
+	start := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+	suite.saveTestBlocks(start, start.Add(time.Minute), start.Add(2*time.Minute))
+
+	suite.saveTestTx("TX1", 1, sdk.NewCoins(), "", "nomic1a")
+	suite.saveTestTx("TX2", 2, sdk.NewCoins(), "", "nomic1a")
+
+	err := suite.database.SaveCommitSignatures([]*types.CommitSig{
+		types.NewCommitSig("val1", 10, 0, 1, start.Add(time.Minute)),
+		types.NewCommitSig("val1", 10, 0, 2, start.Add(2*time.Minute)),
+	})
+	suite.Require().NoError(err)
+
+	// Reprocessing height 2 rewrites its transactions and the commit of height 1
+	err = suite.database.DeleteReprocessedData(2)
+	suite.Require().NoError(err)
+
+	var txHeights []int64
+	err = suite.database.Sqlx.Select(&txHeights, `SELECT height FROM transaction ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{1}, txHeights)
+
+	var commitHeights []int64
+	err = suite.database.Sqlx.Select(&commitHeights, `SELECT height FROM pre_commit ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{2}, commitHeights)
+}
@@ -16,3 +16,17 @@ CREATE TABLE supply
 );
 CREATE INDEX supply_height_index ON supply (height);
 
+
+/* ---- SUPPLY HISTORY ---- */
+/*
+ * Each row represents the total supply of a denom starting from its height. A new row is stored only when the
+ * supply of the denom changes, and a row having an amount of 0 tells that the denom has no supply anymore.
+ */
+CREATE TABLE supply_history
+(
+    denom  TEXT    NOT NULL,
+    amount NUMERIC NOT NULL,
+    height BIGINT  NOT NULL,
+    PRIMARY KEY (denom, height)
+);
+CREATE INDEX supply_history_height_index ON supply_history (height);
@@ -36,6 +36,8 @@ CREATE TABLE transaction
     /* Body */
     memo         TEXT,
     signatures   TEXT[],
+    /* Accounts that signed the messages, see TxMsgValue.GetSigner. Added by migrations/01-transaction-signers.sql */
+    signers      TEXT[]  NOT NULL DEFAULT '{}',
     fee          COIN[] NOT NULL DEFAULT '{}',
     gas          TEXT,
 
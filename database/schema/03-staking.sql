@@ -57,6 +57,10 @@ CREATE TABLE validator_status
 CREATE INDEX validator_status_height_index ON validator_status (height);
 
 /* ---- DOUBLE SIGN VOTE ---- */
+/*
+ * Validators are identified by their consensus address only, since the evidence might involve validators
+ * that have not been stored inside the validator table yet.
+ */
 CREATE TABLE double_sign_vote
 (
     id                SERIAL PRIMARY KEY,
@@ -64,7 +68,7 @@ CREATE TABLE double_sign_vote
     height            BIGINT   NOT NULL,
     round             INT      NOT NULL,
     block_id          TEXT     NOT NULL,
-    validator_address TEXT     NOT NULL REFERENCES validator (consensus_address),
+    validator_address TEXT     NOT NULL,
     validator_index   INT      NOT NULL,
     signature         TEXT     NOT NULL,
     UNIQUE (block_id, validator_address)
@@ -76,8 +80,10 @@ CREATE INDEX double_sign_vote_height_index ON double_sign_vote (height);
 /* ---- DOUBLE SIGN EVIDENCE ---- */
 CREATE TABLE double_sign_evidence
 (
+    id        SERIAL PRIMARY KEY,
     height    BIGINT NOT NULL,
     vote_a_id BIGINT NOT NULL REFERENCES double_sign_vote (id),
-    vote_b_id BIGINT NOT NULL REFERENCES double_sign_vote (id)
+    vote_b_id BIGINT NOT NULL REFERENCES double_sign_vote (id),
+    UNIQUE (vote_a_id, vote_b_id)
 );
 CREATE INDEX double_sign_evidence_height_index ON double_sign_evidence (height);
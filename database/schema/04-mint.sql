@@ -15,7 +15,31 @@ CREATE TABLE staking_pool
     one_row_id        BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
     bonded_tokens     TEXT    NOT NULL,
     not_bonded_tokens TEXT    NOT NULL,
+    bonded_ratio      NUMERIC NOT NULL DEFAULT 0,
     height            BIGINT  NOT NULL,
     CHECK (one_row_id)
 );
-CREATE INDEX staking_pool_height_index ON staking_pool (height);
\ No newline at end of file
+CREATE INDEX staking_pool_height_index ON staking_pool (height);
+
+/* ---- INFLATION HISTORY ---- */
+CREATE TABLE inflation_history
+(
+    value     TEXT                        NOT NULL,
+    height    BIGINT                      NOT NULL PRIMARY KEY,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX inflation_history_timestamp_index ON inflation_history (timestamp);
+
+
+/* ---- STAKING APR ---- */
+CREATE TABLE staking_apr
+(
+    height             BIGINT                      NOT NULL PRIMARY KEY,
+    timestamp          TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    inflation          NUMERIC                     NOT NULL,
+    total_supply       NUMERIC                     NOT NULL,
+    bonded_tokens      NUMERIC                     NOT NULL,
+    average_commission NUMERIC                     NOT NULL,
+    apr                NUMERIC                     NOT NULL
+);
+CREATE INDEX staking_apr_timestamp_index ON staking_apr (timestamp);
@@ -8,3 +8,49 @@ CREATE TABLE token_price
     timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL
 );
 CREATE INDEX token_price_timestamp_index ON token_price (timestamp);
+
+
+/* ---- TOKEN PRICE HISTORY ---- */
+CREATE TABLE token_price_history
+(
+    unit_name  TEXT                        NOT NULL REFERENCES token_unit (denom),
+    price      DECIMAL                     NOT NULL,
+    market_cap BIGINT                      NOT NULL,
+    timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (unit_name, timestamp)
+);
+CREATE INDEX token_price_history_timestamp_index ON token_price_history (timestamp);
+
+
+/* ---- HOURLY TOKEN PRICE CANDLE ---- */
+CREATE TABLE hourly_token_price_candle
+(
+    unit_name  TEXT                        NOT NULL REFERENCES token_unit (denom),
+    period     TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    open       DECIMAL                     NOT NULL,
+    high       DECIMAL                     NOT NULL,
+    low        DECIMAL                     NOT NULL,
+    close      DECIMAL                     NOT NULL,
+    /* Market cap at the time of the closing price */
+    market_cap BIGINT                      NOT NULL,
+    samples    BIGINT                      NOT NULL,
+    PRIMARY KEY (unit_name, period)
+);
+CREATE INDEX hourly_token_price_candle_period_index ON hourly_token_price_candle (period);
+
+
+/* ---- DAILY TOKEN PRICE CANDLE ---- */
+CREATE TABLE daily_token_price_candle
+(
+    unit_name  TEXT                        NOT NULL REFERENCES token_unit (denom),
+    period     TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    open       DECIMAL                     NOT NULL,
+    high       DECIMAL                     NOT NULL,
+    low        DECIMAL                     NOT NULL,
+    close      DECIMAL                     NOT NULL,
+    /* Market cap at the time of the closing price */
+    market_cap BIGINT                      NOT NULL,
+    samples    BIGINT                      NOT NULL,
+    PRIMARY KEY (unit_name, period)
+);
+CREATE INDEX daily_token_price_candle_period_index ON daily_token_price_candle (period);
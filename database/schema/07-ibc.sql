@@ -7,3 +7,121 @@ CREATE TABLE ibc_transfer_params
     CHECK (one_row_id)
 );
 CREATE INDEX ibc_transfer_params_height_index ON ibc_transfer_params (height);
+
+
+/* ---- IBC PACKET ---- */
+CREATE TABLE ibc_packet
+(
+    source_port          TEXT    NOT NULL,
+    source_channel       TEXT    NOT NULL,
+    sequence             NUMERIC NOT NULL,
+    destination_port     TEXT    NOT NULL,
+    destination_channel  TEXT    NOT NULL,
+    data                 TEXT,
+    timeout_height       TEXT,
+    timeout_timestamp    NUMERIC,
+    status               TEXT    NOT NULL,
+    send_tx_hash         TEXT,
+    send_height          BIGINT,
+    recv_tx_hash         TEXT,
+    recv_height          BIGINT,
+    recv_relayer_address TEXT,
+    ack_tx_hash          TEXT,
+    ack_height           BIGINT,
+    ack_relayer_address  TEXT,
+    /* The source identifiers are chosen by the counterparty for the received packets, so they are unique
+       only together with the destination ones */
+    PRIMARY KEY (source_port, source_channel, sequence, destination_port, destination_channel)
+);
+CREATE INDEX ibc_packet_destination_channel_index ON ibc_packet (destination_port, destination_channel);
+CREATE INDEX ibc_packet_status_index ON ibc_packet (status);
+
+
+/* ---- IBC TRANSFER ---- */
+CREATE TABLE ibc_transfer
+(
+    source_port      TEXT                        NOT NULL,
+    source_channel   TEXT                        NOT NULL,
+    sequence         NUMERIC                     NOT NULL,
+    direction        TEXT                        NOT NULL,
+    channel          TEXT                        NOT NULL,
+    denom            TEXT                        NOT NULL,
+    amount           NUMERIC                     NOT NULL,
+    sender           TEXT                        NOT NULL,
+    receiver         TEXT                        NOT NULL,
+    transaction_hash TEXT                        NOT NULL,
+    height           BIGINT                      NOT NULL,
+    timestamp        TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (source_port, source_channel, sequence, direction, channel)
+);
+CREATE INDEX ibc_transfer_channel_index ON ibc_transfer (channel, timestamp);
+CREATE INDEX ibc_transfer_sender_index ON ibc_transfer (sender);
+CREATE INDEX ibc_transfer_receiver_index ON ibc_transfer (receiver);
+
+/* Daily volume transferred through each channel */
+CREATE VIEW ibc_channel_volume AS
+SELECT channel,
+       direction,
+       denom,
+       DATE_TRUNC('day', timestamp) AS day,
+       COUNT(*)                     AS transfers_count,
+       SUM(amount)                  AS volume
+FROM ibc_transfer
+GROUP BY channel, direction, denom, DATE_TRUNC('day', timestamp);
+
+
+/* ---- IBC CLIENT ---- */
+CREATE TABLE ibc_client
+(
+    client_id        TEXT                        NOT NULL PRIMARY KEY,
+    chain_id         TEXT                        NOT NULL,
+    trusting_period  BIGINT                      NOT NULL,
+    latest_height    TEXT                        NOT NULL,
+    latest_timestamp TIMESTAMP WITHOUT TIME ZONE,
+    frozen_height    TEXT,
+    status           TEXT                        NOT NULL,
+    height           BIGINT                      NOT NULL
+);
+CREATE INDEX ibc_client_chain_id_index ON ibc_client (chain_id);
+CREATE INDEX ibc_client_status_index ON ibc_client (status);
+
+
+/* ---- IBC CONNECTION ---- */
+CREATE TABLE ibc_connection
+(
+    connection_id              TEXT   NOT NULL PRIMARY KEY,
+    client_id                  TEXT   NOT NULL,
+    state                      TEXT   NOT NULL,
+    counterparty_client_id     TEXT   NOT NULL,
+    counterparty_connection_id TEXT,
+    delay_period               BIGINT NOT NULL,
+    height                     BIGINT NOT NULL
+);
+CREATE INDEX ibc_connection_client_id_index ON ibc_connection (client_id);
+
+
+/* ---- IBC CHANNEL ---- */
+CREATE TABLE ibc_channel
+(
+    port_id                 TEXT   NOT NULL,
+    channel_id              TEXT   NOT NULL,
+    state                   TEXT   NOT NULL,
+    ordering                TEXT   NOT NULL,
+    counterparty_port_id    TEXT   NOT NULL,
+    counterparty_channel_id TEXT,
+    connection_hops         TEXT[] NOT NULL,
+    version                 TEXT   NOT NULL,
+    height                  BIGINT NOT NULL,
+    PRIMARY KEY (port_id, channel_id)
+);
+
+
+/* ---- IBC DENOM TRACE ---- */
+CREATE TABLE ibc_denom_trace
+(
+    denom      TEXT   NOT NULL PRIMARY KEY,
+    path       TEXT   NOT NULL,
+    base_denom TEXT   NOT NULL,
+    height     BIGINT NOT NULL
+);
+CREATE INDEX ibc_denom_trace_base_denom_index ON ibc_denom_trace (base_denom);
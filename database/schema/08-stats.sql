@@ -0,0 +1,40 @@
+/* ---- DAILY CHAIN STATS ---- */
+CREATE TABLE daily_chain_stats
+(
+    period             TIMESTAMP WITHOUT TIME ZONE NOT NULL PRIMARY KEY,
+    blocks             BIGINT                      NOT NULL DEFAULT 0,
+    txs                BIGINT                      NOT NULL DEFAULT 0,
+    total_gas          NUMERIC                     NOT NULL DEFAULT 0,
+    fees               COIN[]                      NOT NULL DEFAULT '{}',
+    /*
+     * Number of distinct accounts that signed at least one transaction inside the period.
+     * Since the stored transactions do not contain their signers public keys, the signer of each message
+     * is approximated by its explicit signer, its delegator or its sender (see TxMsgValue.GetSigner)
+     */
+    unique_signers     BIGINT                      NOT NULL DEFAULT 0,
+    average_block_time DECIMAL                     NOT NULL DEFAULT 0,
+    first_height       BIGINT                      NOT NULL,
+    last_height        BIGINT                      NOT NULL
+);
+CREATE INDEX daily_chain_stats_last_height_index ON daily_chain_stats (last_height);
+
+
+/* ---- HOURLY CHAIN STATS ---- */
+CREATE TABLE hourly_chain_stats
+(
+    period             TIMESTAMP WITHOUT TIME ZONE NOT NULL PRIMARY KEY,
+    blocks             BIGINT                      NOT NULL DEFAULT 0,
+    txs                BIGINT                      NOT NULL DEFAULT 0,
+    total_gas          NUMERIC                     NOT NULL DEFAULT 0,
+    fees               COIN[]                      NOT NULL DEFAULT '{}',
+    /*
+     * Number of distinct accounts that signed at least one transaction inside the period.
+     * Since the stored transactions do not contain their signers public keys, the signer of each message
+     * is approximated by its explicit signer, its delegator or its sender (see TxMsgValue.GetSigner)
+     */
+    unique_signers     BIGINT                      NOT NULL DEFAULT 0,
+    average_block_time DECIMAL                     NOT NULL DEFAULT 0,
+    first_height       BIGINT                      NOT NULL,
+    last_height        BIGINT                      NOT NULL
+);
+CREATE INDEX hourly_chain_stats_last_height_index ON hourly_chain_stats (last_height);
@@ -0,0 +1,25 @@
+/* ---- VERIFICATION ISSUE ---- */
+CREATE TABLE verification_issue
+(
+    id         SERIAL                      NOT NULL PRIMARY KEY,
+    height     BIGINT                      NOT NULL,
+    type       TEXT                        NOT NULL,
+    /* Value found on the chain, or the chain items missing from the database */
+    expected   TEXT,
+    /* Value found inside the database, or the database items not present on the chain */
+    actual     TEXT,
+    reenqueued BOOLEAN                     NOT NULL DEFAULT FALSE,
+    timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    UNIQUE (height, type)
+);
+CREATE INDEX verification_issue_height_index ON verification_issue (height);
+CREATE INDEX verification_issue_type_index ON verification_issue (type);
+
+
+/* ---- VERIFICATION PROGRESS ---- */
+CREATE TABLE verification_progress
+(
+    one_row_id           BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    last_verified_height BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
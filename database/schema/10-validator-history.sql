@@ -0,0 +1,74 @@
+/* ---- VALIDATOR DESCRIPTION HISTORY ---- */
+CREATE TABLE validator_description_history
+(
+    self_delegate_address TEXT                        NOT NULL REFERENCES validator (self_delegate_address),
+    validator_address     TEXT                        NOT NULL,
+    moniker               TEXT,
+    identity              TEXT,
+    avatar_url            TEXT,
+    details               TEXT,
+    height                BIGINT                      NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (self_delegate_address, height)
+);
+CREATE INDEX validator_description_history_height_index ON validator_description_history (height);
+
+
+/* ---- VALIDATOR COMMISSION HISTORY ---- */
+CREATE TABLE validator_commission_history
+(
+    self_delegate_address TEXT                        NOT NULL REFERENCES validator (self_delegate_address),
+    validator_address     TEXT                        NOT NULL,
+    commission            TEXT                        NOT NULL,
+    min_self_delegation   TEXT                        NOT NULL DEFAULT '0',
+    height                BIGINT                      NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (self_delegate_address, height)
+);
+CREATE INDEX validator_commission_history_height_index ON validator_commission_history (height);
+
+
+/* ---- VALIDATOR STATUS HISTORY ---- */
+CREATE TABLE validator_status_history
+(
+    self_delegate_address TEXT                        NOT NULL REFERENCES validator (self_delegate_address),
+    validator_address     TEXT                        NOT NULL,
+    in_active_set         TEXT                        NOT NULL,
+    jailed                TEXT                        NOT NULL,
+    tombstoned            TEXT                        NOT NULL,
+    height                BIGINT                      NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (self_delegate_address, height)
+);
+CREATE INDEX validator_status_history_height_index ON validator_status_history (height);
+
+
+/* ---- VALIDATOR VOTING POWER HISTORY ---- */
+CREATE TABLE validator_voting_power_history
+(
+    self_delegate_address TEXT                        NOT NULL REFERENCES validator (self_delegate_address),
+    validator_address     TEXT                        NOT NULL,
+    voting_power          TEXT                        NOT NULL DEFAULT '0',
+    height                BIGINT                      NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (self_delegate_address, height)
+);
+CREATE INDEX validator_voting_power_history_height_index ON validator_voting_power_history (height);
+
+
+/* ---- VALIDATOR EVENT ---- */
+CREATE TABLE validator_event
+(
+    id                    SERIAL                      NOT NULL PRIMARY KEY,
+    self_delegate_address TEXT                        NOT NULL REFERENCES validator (self_delegate_address),
+    validator_address     TEXT                        NOT NULL,
+    type                  TEXT                        NOT NULL,
+    old_value             TEXT,
+    new_value             TEXT,
+    height                BIGINT                      NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    UNIQUE (self_delegate_address, type, height)
+);
+CREATE INDEX validator_event_self_delegate_address_index ON validator_event (self_delegate_address);
+CREATE INDEX validator_event_type_index ON validator_event (type);
+CREATE INDEX validator_event_height_index ON validator_event (height);
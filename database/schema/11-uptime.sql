@@ -0,0 +1,33 @@
+/* ---- VALIDATOR SIGNING WINDOW ---- */
+/* Contains the signing status of each validator for the latest window_size heights only */
+CREATE TABLE validator_signing_window
+(
+    validator_address TEXT    NOT NULL,
+    height            BIGINT  NOT NULL,
+    signed            BOOLEAN NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_signing_window_height_index ON validator_signing_window (height);
+
+
+/* ---- VALIDATOR UPTIME ---- */
+CREATE TABLE validator_uptime
+(
+    validator_address    TEXT   NOT NULL PRIMARY KEY,
+    window_size          BIGINT NOT NULL,
+    window_signed_blocks BIGINT NOT NULL DEFAULT 0,
+    window_missed_blocks BIGINT NOT NULL DEFAULT 0,
+    signed_blocks        BIGINT NOT NULL DEFAULT 0,
+    missed_blocks        BIGINT NOT NULL DEFAULT 0,
+    last_signed_height   BIGINT,
+    height               BIGINT NOT NULL
+);
+CREATE INDEX validator_uptime_height_index ON validator_uptime (height);
+
+
+/* ---- VALIDATOR UPTIME PROCESSED HEIGHT ---- */
+/* Contains the heights whose signatures have already been counted inside the validators uptime */
+CREATE TABLE validator_uptime_processed_height
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
@@ -0,0 +1,29 @@
+/* ---- VALIDATOR SET ---- */
+/*
+ * When the validator set is stored only on change, each row represents the state of a validator starting from
+ * its height, and a row having a voting power of 0 tells that the validator left the set.
+ * Validators are identified by their consensus address only, since the set contains validators that might not
+ * have been stored inside the validator table yet. Those validators are registered inside unknown_validator.
+ */
+CREATE TABLE validator_set
+(
+    validator_address TEXT   NOT NULL,
+    voting_power      BIGINT NOT NULL,
+    proposer_priority BIGINT NOT NULL,
+    height            BIGINT NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_set_height_index ON validator_set (height);
+
+
+/* ---- UNKNOWN VALIDATOR ---- */
+/*
+ * Validators that are part of the validator set but are not stored inside the validator table, along with the
+ * lowest height at which they have been seen. The validators list does not contain the consensus addresses, so
+ * their self delegate address can not be resolved. Rows are removed once the validator gets stored.
+ */
+CREATE TABLE unknown_validator
+(
+    consensus_address TEXT   NOT NULL PRIMARY KEY,
+    height            BIGINT NOT NULL
+);
@@ -0,0 +1,17 @@
+/* ---- VALIDATOR PROPOSER STATS ---- */
+CREATE TABLE validator_proposer_stats
+(
+    validator_address  TEXT      NOT NULL,
+    date               TIMESTAMP NOT NULL,
+    proposed_blocks    BIGINT    NOT NULL DEFAULT 0,
+    /* Number of proposals expected based on the validator share of the validator set voting power */
+    expected_proposals NUMERIC   NOT NULL DEFAULT 0,
+    /* Longest number of blocks between two consecutive proposals ending in this date,
+       or the number of blocks of the date if the validator did not propose any of them */
+    longest_gap        BIGINT    NOT NULL DEFAULT 0,
+    PRIMARY KEY (validator_address, date)
+);
+CREATE INDEX validator_proposer_stats_date_index ON validator_proposer_stats (date);
+
+/* Allows to quickly find the previous block proposed by a validator */
+CREATE INDEX block_proposer_address_height_index ON block (proposer_address, height);
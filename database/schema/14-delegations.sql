@@ -0,0 +1,61 @@
+/* ---- DELEGATION ---- */
+/*
+ * The rows of each delegator are replaced as a whole every time its delegations are refreshed,
+ * and the height tells at which block the refresh happened.
+ */
+CREATE TABLE delegation
+(
+    delegator_address TEXT    NOT NULL,
+    validator_address TEXT    NOT NULL,
+    amount            COIN    NOT NULL,
+    shares            NUMERIC NOT NULL,
+    height            BIGINT  NOT NULL,
+    PRIMARY KEY (delegator_address, validator_address)
+);
+CREATE INDEX delegation_validator_address_index ON delegation (validator_address);
+CREATE INDEX delegation_height_index ON delegation (height);
+
+
+/* ---- UNBONDING DELEGATION ---- */
+CREATE TABLE unbonding_delegation
+(
+    delegator_address    TEXT                        NOT NULL,
+    validator_address    TEXT                        NOT NULL,
+    initial_balance      NUMERIC                     NOT NULL,
+    balance              NUMERIC                     NOT NULL,
+    creation_height      BIGINT                      NOT NULL,
+    completion_timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height               BIGINT                      NOT NULL
+);
+CREATE INDEX unbonding_delegation_delegator_address_index ON unbonding_delegation (delegator_address);
+CREATE INDEX unbonding_delegation_validator_address_index ON unbonding_delegation (validator_address);
+CREATE INDEX unbonding_delegation_completion_timestamp_index ON unbonding_delegation (completion_timestamp);
+
+
+/* ---- REDELEGATION ---- */
+CREATE TABLE redelegation
+(
+    delegator_address     TEXT                        NOT NULL,
+    src_validator_address TEXT                        NOT NULL,
+    dst_validator_address TEXT                        NOT NULL,
+    initial_balance       NUMERIC                     NOT NULL,
+    balance               NUMERIC                     NOT NULL,
+    creation_height       BIGINT                      NOT NULL,
+    completion_time       TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height                BIGINT                      NOT NULL
+);
+CREATE INDEX redelegation_delegator_address_index ON redelegation (delegator_address);
+CREATE INDEX redelegation_src_validator_address_index ON redelegation (src_validator_address);
+CREATE INDEX redelegation_dst_validator_address_index ON redelegation (dst_validator_address);
+
+
+/* ---- DELEGATIONS REFRESH ---- */
+/*
+ * Contains the height of the latest refresh of each delegator, so that older refreshes are ignored
+ * even after all the delegator rows have been removed.
+ */
+CREATE TABLE delegations_refresh
+(
+    delegator_address TEXT   NOT NULL PRIMARY KEY,
+    height            BIGINT NOT NULL
+);
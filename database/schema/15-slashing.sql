@@ -0,0 +1,38 @@
+/* ---- LIGHT CLIENT ATTACK EVIDENCE ---- */
+CREATE TABLE light_client_attack_evidence
+(
+    id                       SERIAL PRIMARY KEY,
+    hash                     TEXT                        NOT NULL UNIQUE,
+    conflicting_block_hash   TEXT                        NOT NULL,
+    conflicting_block_height BIGINT                      NOT NULL,
+    common_height            BIGINT                      NOT NULL,
+    byzantine_validators     TEXT[]                      NOT NULL,
+    total_voting_power       BIGINT                      NOT NULL,
+    timestamp                TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height                   BIGINT                      NOT NULL
+);
+CREATE INDEX light_client_attack_evidence_height_index ON light_client_attack_evidence (height);
+
+
+/* ---- VALIDATOR SLASHING ---- */
+/*
+ * Each row represents a slash or jail event emitted for a validator. When the penalty has been caused by an
+ * evidence included inside the same block, the row references it.
+ * Validators are identified by their consensus address only, since they might not have been stored
+ * inside the validator table yet.
+ */
+CREATE TABLE validator_slashing
+(
+    validator_address               TEXT    NOT NULL,
+    infraction_height               BIGINT,
+    power                           TEXT,
+    reason                          TEXT    NOT NULL,
+    jailed                          BOOLEAN NOT NULL DEFAULT FALSE,
+    burned_coins                    TEXT,
+    double_sign_evidence_id         BIGINT REFERENCES double_sign_evidence (id),
+    light_client_attack_evidence_id BIGINT REFERENCES light_client_attack_evidence (id),
+    height                          BIGINT  NOT NULL,
+    UNIQUE (validator_address, reason, height)
+);
+CREATE INDEX validator_slashing_validator_address_index ON validator_slashing (validator_address);
+CREATE INDEX validator_slashing_height_index ON validator_slashing (height);
@@ -0,0 +1,11 @@
+/* ---- VALIDATOR AVATAR ---- */
+/*
+ * Cache of the avatars resolved for each validator identity. An avatar is resolved again only when the identity
+ * is not cached yet or when its entry is older than the configured TTL.
+ */
+CREATE TABLE validator_avatar
+(
+    identity   TEXT                        NOT NULL PRIMARY KEY,
+    avatar_url TEXT,
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
@@ -0,0 +1,14 @@
+/* ---- DECENTRALIZATION METRICS ---- */
+CREATE TABLE decentralization_metrics
+(
+    height                  BIGINT                      NOT NULL PRIMARY KEY,
+    timestamp               TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    nakamoto_coefficient_33 BIGINT                      NOT NULL,
+    nakamoto_coefficient_67 BIGINT                      NOT NULL,
+    gini_coefficient        NUMERIC                     NOT NULL,
+    top_10_share            NUMERIC                     NOT NULL,
+    top_20_share            NUMERIC                     NOT NULL,
+    active_set_size         BIGINT                      NOT NULL,
+    total_voting_power      NUMERIC                     NOT NULL
+);
+CREATE INDEX decentralization_metrics_timestamp_index ON decentralization_metrics (timestamp);
@@ -0,0 +1,19 @@
+/* ---- ACCOUNT BALANCE ---- */
+CREATE TABLE account_balance
+(
+    address TEXT   NOT NULL PRIMARY KEY,
+    coins   COIN[] NOT NULL DEFAULT '{}',
+    height  BIGINT NOT NULL
+);
+CREATE INDEX account_balance_height_index ON account_balance (height);
+
+
+/* ---- ACCOUNT BALANCE HISTORY ---- */
+CREATE TABLE account_balance_history
+(
+    address TEXT   NOT NULL,
+    coins   COIN[] NOT NULL DEFAULT '{}',
+    height  BIGINT NOT NULL,
+    PRIMARY KEY (address, height)
+);
+CREATE INDEX account_balance_history_height_index ON account_balance_history (height);
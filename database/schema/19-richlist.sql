@@ -0,0 +1,40 @@
+/* ---- RICH LIST ---- */
+CREATE TABLE rich_list
+(
+    denom   TEXT    NOT NULL,
+    rank    BIGINT  NOT NULL,
+    address TEXT    NOT NULL,
+    amount  NUMERIC NOT NULL,
+    share   NUMERIC NOT NULL,
+    label   TEXT,
+    height  BIGINT  NOT NULL,
+    PRIMARY KEY (denom, rank)
+);
+CREATE INDEX rich_list_address_index ON rich_list (address);
+
+
+/* ---- HOLDERS BUCKET ---- */
+CREATE TABLE holders_bucket
+(
+    denom         TEXT    NOT NULL,
+    min_amount    NUMERIC NOT NULL,
+    max_amount    NUMERIC,
+    holders_count BIGINT  NOT NULL,
+    total_amount  NUMERIC NOT NULL,
+    height        BIGINT  NOT NULL,
+    PRIMARY KEY (denom, min_amount)
+);
+
+
+/* ---- HOLDERS DISTRIBUTION ---- */
+CREATE TABLE holders_distribution
+(
+    denom         TEXT    NOT NULL PRIMARY KEY,
+    holders_count BIGINT  NOT NULL,
+    total_amount  NUMERIC NOT NULL,
+    supply        NUMERIC NOT NULL,
+    top_1_share   NUMERIC NOT NULL,
+    top_10_share  NUMERIC NOT NULL,
+    top_100_share NUMERIC NOT NULL,
+    height        BIGINT  NOT NULL
+);
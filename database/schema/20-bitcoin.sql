@@ -0,0 +1,42 @@
+/* ---- BTC DEPOSIT ---- */
+CREATE TABLE btc_deposit
+(
+    txid             TEXT    NOT NULL,
+    vout             BIGINT  NOT NULL,
+    receiver_address TEXT    NOT NULL,
+    amount           NUMERIC NOT NULL,
+    transaction_hash TEXT,
+    height           BIGINT  NOT NULL,
+    PRIMARY KEY (txid, vout)
+);
+CREATE INDEX btc_deposit_receiver_address_index ON btc_deposit (receiver_address);
+CREATE INDEX btc_deposit_height_index ON btc_deposit (height);
+
+
+/* ---- BTC WITHDRAWAL ---- */
+CREATE TABLE btc_withdrawal
+(
+    transaction_hash    TEXT   NOT NULL,
+    msg_index           BIGINT NOT NULL,
+    sender_address      TEXT   NOT NULL,
+    destination_address TEXT   NOT NULL,
+    amount              COIN   NOT NULL,
+    height              BIGINT NOT NULL,
+    PRIMARY KEY (transaction_hash, msg_index)
+);
+CREATE INDEX btc_withdrawal_sender_address_index ON btc_withdrawal (sender_address);
+CREATE INDEX btc_withdrawal_height_index ON btc_withdrawal (height);
+
+
+/* ---- BTC CHECKPOINT ---- */
+CREATE TABLE btc_checkpoint
+(
+    index               BIGINT NOT NULL PRIMARY KEY,
+    status              TEXT   NOT NULL,
+    txid                TEXT,
+    fee_rate            BIGINT NOT NULL,
+    signatory_set_index BIGINT NOT NULL,
+    signatory_set       JSONB  NOT NULL DEFAULT '{}'::JSONB,
+    height              BIGINT NOT NULL
+);
+CREATE INDEX btc_checkpoint_status_index ON btc_checkpoint (status);
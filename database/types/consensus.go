@@ -43,3 +43,14 @@ func (v GenesisRow) Equal(w GenesisRow) bool {
 		v.Time == w.Time &&
 		v.InitialHeight == w.InitialHeight
 }
+
+// _________________________________________________________
+
+// PreCommitRow represents a single pre_commit row stored inside the database
+type PreCommitRow struct {
+	ValidatorAddress string    `db:"validator_address"`
+	Height           int64     `db:"height"`
+	Timestamp        time.Time `db:"timestamp"`
+	VotingPower      int64     `db:"voting_power"`
+	ProposerPriority int64     `db:"proposer_priority"`
+}
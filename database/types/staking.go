@@ -1,6 +1,9 @@
 package types
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // ValidatorCommissionRow represents a single row of the validator_commission database table
 type ValidatorCommissionRow struct {
@@ -105,3 +108,31 @@ func (v ValidatorStatusRow) Equal(w ValidatorStatusRow) bool {
 		v.Tombstoned == w.Tombstoned &&
 		v.Height == w.Height
 }
+
+// _________________________________________________________
+
+// ValidatorRow represents a single row of the validator table
+type ValidatorRow struct {
+	ConsAddress         string `db:"consensus_address"`
+	SelfDelegateAddress string `db:"self_delegate_address"`
+	Height              int64  `db:"height"`
+}
+
+// _________________________________________________________
+
+// ValidatorVotingPowerRow represents a single row of the validator_voting_power table
+type ValidatorVotingPowerRow struct {
+	ConsAddress         string `db:"validator_address"`
+	SelfDelegateAddress string `db:"self_delegate_address"`
+	VotingPower         string `db:"voting_power"`
+	Height              int64  `db:"height"`
+}
+
+// _________________________________________________________
+
+// ValidatorAvatarRow represents a single row of the validator_avatar table
+type ValidatorAvatarRow struct {
+	Identity  string         `db:"identity"`
+	AvatarURL sql.NullString `db:"avatar_url"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
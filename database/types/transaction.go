@@ -1,6 +1,12 @@
 package types
 
-import "github.com/forbole/njuno/types"
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/forbole/njuno/types"
+)
 
 // NewDBSignatures returns signatures in string array
 func NewDBSignatures(signaturesList []types.TxSignatures) []string {
@@ -10,3 +16,17 @@ func NewDBSignatures(signaturesList []types.TxSignatures) []string {
 	}
 	return signatures
 }
+
+// _________________________________________________________
+
+// TransactionRow represents a single transaction row stored inside the database
+type TransactionRow struct {
+	Hash        string         `db:"hash"`
+	Height      int64          `db:"height"`
+	Memo        sql.NullString `db:"memo"`
+	Signatures  pq.StringArray `db:"signatures"`
+	Signers     pq.StringArray `db:"signers"`
+	Fee         string         `db:"fee"`
+	Gas         sql.NullString `db:"gas"`
+	PartitionID int64          `db:"partition_id"`
+}
@@ -9,6 +9,10 @@ import (
 type Config struct {
 	Port uint            `yaml:"port"`
 	Node *remote.Details `yaml:"node,omitempty"`
+
+	// BalancesLiveFallback tells whether the account balance action should query the node
+	// when the requested balance has not been indexed yet
+	BalancesLiveFallback bool `yaml:"balances_live_fallback,omitempty"`
 }
 
 // NewConfig returns a new Config instance
@@ -16,7 +16,7 @@ var (
 
 func (m *Module) RunAdditionalOperations() error {
 	// Build the worker
-	context := actionstypes.NewContext(m.node)
+	context := actionstypes.NewContext(m.node, m.db, m.supply, m.cfg.BalancesLiveFallback)
 	worker := actionstypes.NewActionsWorker(context)
 
 	// -- Register the Account Balance endpoint --
@@ -25,6 +25,16 @@ func (m *Module) RunAdditionalOperations() error {
 	// -- Staking Delegator --
 	worker.RegisterHandler("/delegation_total", handlers.TotalDelegationsAmountHandler)
 
+	// -- Decentralization --
+	worker.RegisterHandler("/decentralization_metrics", handlers.DecentralizationMetricsHandler)
+
+	// -- Mint --
+	worker.RegisterHandler("/apr", handlers.StakingAPRHandler)
+
+	// -- Supply --
+	worker.RegisterPlainHandler("/total_supply", handlers.TotalSupplyHandler)
+	worker.RegisterPlainHandler("/circulating_supply", handlers.CirculatingSupplyHandler)
+
 	// Listen for and trap any OS signal to gracefully shutdown and exit
 	m.trapSignal()
 
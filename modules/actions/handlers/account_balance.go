@@ -13,12 +13,27 @@ func AccountBalanceHandler(ctx *types.Context, payload *types.Payload) (interfac
 		Int64("height", payload.Input.Height).
 		Msg("executing account balance action")
 
-	balance, err := ctx.Node.AccountBalance(payload.GetAddress())
+	balance, err := ctx.Database.GetAccountBalance(payload.GetAddress(), payload.Input.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account balance from database: %s", err)
+	}
+
+	if balance != nil {
+		return types.Balance{
+			Coins: types.ConvertCoins(balance.Balance),
+		}, nil
+	}
+
+	if !ctx.BalancesLiveFallback {
+		return nil, fmt.Errorf("no balance found for address %s", payload.GetAddress())
+	}
+
+	coins, err := ctx.Node.AccountBalance(payload.GetAddress(), payload.Input.Height)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting account balance: %s", err)
 	}
 
 	return types.Balance{
-		Coins: types.ConvertCoins(balance),
+		Coins: types.ConvertCoins(coins),
 	}, nil
 }
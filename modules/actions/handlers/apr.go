@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/modules/actions/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func StakingAPRHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Int64("height", payload.Input.Height).
+		Msg("executing staking apr action")
+
+	apr, err := ctx.Database.GetStakingAPR(payload.Input.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking apr: %s", err)
+	}
+
+	if apr == nil {
+		return nil, fmt.Errorf("no staking apr found")
+	}
+
+	return types.StakingAPR{
+		APR:               apr.APR.String(),
+		Inflation:         apr.Inflation.String(),
+		TotalSupply:       apr.TotalSupply.String(),
+		BondedTokens:      apr.BondedTokens.String(),
+		AverageCommission: apr.AverageCommission.String(),
+		Height:            apr.Height,
+		Timestamp:         apr.Timestamp.Format(time.RFC3339),
+	}, nil
+}
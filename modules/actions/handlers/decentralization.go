@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/njuno/modules/actions/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func DecentralizationMetricsHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Int64("height", payload.Input.Height).
+		Msg("executing decentralization metrics action")
+
+	metrics, err := ctx.Database.GetDecentralizationMetrics(payload.Input.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting decentralization metrics: %s", err)
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("no decentralization metrics found")
+	}
+
+	return types.DecentralizationMetrics{
+		NakamotoCoefficient33: metrics.NakamotoCoefficient33,
+		NakamotoCoefficient67: metrics.NakamotoCoefficient67,
+		GiniCoefficient:       metrics.GiniCoefficient.String(),
+		Top10Share:            metrics.Top10Share.String(),
+		Top20Share:            metrics.Top20Share.String(),
+		ActiveSetSize:         metrics.ActiveSetSize,
+		TotalVotingPower:      metrics.TotalVotingPower.String(),
+		Height:                metrics.Height,
+		Timestamp:             metrics.Timestamp.Format(time.RFC3339),
+	}, nil
+}
@@ -3,7 +3,6 @@ package handlers
 import (
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/forbole/njuno/modules/actions/types"
 	"github.com/rs/zerolog/log"
 )
@@ -19,6 +18,6 @@ func TotalDelegationsAmountHandler(ctx *types.Context, payload *types.Payload) (
 	}
 
 	return types.Balance{
-		Coins: types.ConvertCoins(sdk.NewCoins(balance)),
+		Coins: types.ConvertCoins(balance),
 	}, nil
 }
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/actions/types"
+	"github.com/forbole/njuno/modules/bank/supply"
+)
+
+func TotalSupplyHandler(ctx *types.Context) (string, error) {
+	log.Debug().Msg("executing total supply action")
+
+	amount, err := ctx.Supply.GetTotalSupply()
+	if err != nil {
+		return "", err
+	}
+
+	return formatSupply(ctx, amount)
+}
+
+func CirculatingSupplyHandler(ctx *types.Context) (string, error) {
+	log.Debug().Msg("executing circulating supply action")
+
+	amount, err := ctx.Supply.GetCirculatingSupply()
+	if err != nil {
+		return "", err
+	}
+
+	return formatSupply(ctx, amount)
+}
+
+// formatSupply converts the given supply amount into the display unit of the supply denom token.
+// If no token is found, the amount is returned in the base denom instead
+func formatSupply(ctx *types.Context, amount sdk.Int) (string, error) {
+	token, err := ctx.Database.GetToken(ctx.Supply.Denom())
+	if err != nil {
+		return "", fmt.Errorf("error while getting token: %s", err)
+	}
+
+	if token == nil || token.GetDisplayUnit() == nil {
+		return amount.String(), nil
+	}
+
+	// The supply denom might not be the token unit having exponent zero
+	exponent := token.GetDisplayUnit().Exponent
+	for _, unit := range token.Units {
+		if unit.Denom == ctx.Supply.Denom() {
+			exponent -= unit.Exponent
+		}
+	}
+
+	return supply.FormatAmount(amount, exponent), nil
+}
@@ -2,7 +2,9 @@ package actions
 
 import (
 	"github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/forbole/njuno/database"
 	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/bank/supply"
 	"github.com/forbole/njuno/node"
 	"github.com/forbole/njuno/node/builder"
 	nodeconfig "github.com/forbole/njuno/node/config"
@@ -15,11 +17,15 @@ var (
 )
 
 type Module struct {
-	cfg  *Config
-	node node.Node
+	cfg    *Config
+	node   node.Node
+	db     database.Database
+	supply *supply.CirculatingCalculator
 }
 
-func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig) *Module {
+func NewModule(
+	cfg config.Config, encodingConfig *params.EncodingConfig, db database.Database, supplyProvider *supply.Provider,
+) *Module {
 	bz, err := cfg.GetBytes()
 	if err != nil {
 		panic(err)
@@ -42,8 +48,10 @@ func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig) *Module
 	}
 
 	return &Module{
-		cfg:  actionsCfg,
-		node: nJunoNode,
+		cfg:    actionsCfg,
+		node:   nJunoNode,
+		db:     db,
+		supply: supply.NewCirculatingCalculator(supplyProvider, db, nJunoNode),
 	}
 }
 
@@ -1,19 +1,37 @@
 package types
 
-import "github.com/forbole/njuno/node"
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules/bank/supply"
+	"github.com/forbole/njuno/node"
+)
 
 // ActionHandler represents a Hasura action request handler.
 // It returns an interface to be returned to the called, or an error if something is wrong
 type ActionHandler = func(context *Context, payload *Payload) (interface{}, error)
 
+// PlainHandler represents a plaintext request handler.
+// It returns the text to be returned to the caller, or an error if something is wrong
+type PlainHandler = func(context *Context) (string, error)
+
 // Context contains the data about a Hasura actions worker execution
 type Context struct {
-	Node node.Node
+	Node     node.Node
+	Database database.Database
+	Supply   *supply.CirculatingCalculator
+
+	// BalancesLiveFallback tells whether balances not found inside the database should be read from the node
+	BalancesLiveFallback bool
 }
 
 // NewContext returns a new Context instance
-func NewContext(node node.Node) *Context {
+func NewContext(
+	node node.Node, db database.Database, supply *supply.CirculatingCalculator, balancesLiveFallback bool,
+) *Context {
 	return &Context{
-		Node: node,
+		Node:                 node,
+		Database:             db,
+		Supply:               supply,
+		BalancesLiveFallback: balancesLiveFallback,
 	}
 }
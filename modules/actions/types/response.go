@@ -20,3 +20,28 @@ func ConvertCoins(coins sdk.Coins) []Coin {
 type Balance struct {
 	Coins []Coin `json:"coins"`
 }
+
+// DecentralizationMetrics represents the response of the decentralization metrics action,
+// computed over the voting power of the active validator set at the given height
+type DecentralizationMetrics struct {
+	NakamotoCoefficient33 int64  `json:"nakamoto_coefficient_33"`
+	NakamotoCoefficient67 int64  `json:"nakamoto_coefficient_67"`
+	GiniCoefficient       string `json:"gini_coefficient"`
+	Top10Share            string `json:"top_10_share"`
+	Top20Share            string `json:"top_20_share"`
+	ActiveSetSize         int64  `json:"active_set_size"`
+	TotalVotingPower      string `json:"total_voting_power"`
+	Height                int64  `json:"height"`
+	Timestamp             string `json:"timestamp"`
+}
+
+// StakingAPR represents the response of the staking APR action, along with the values used to compute it
+type StakingAPR struct {
+	APR               string `json:"apr"`
+	Inflation         string `json:"inflation"`
+	TotalSupply       string `json:"total_supply"`
+	BondedTokens      string `json:"bonded_tokens"`
+	AverageCommission string `json:"average_commission"`
+	Height            int64  `json:"height"`
+	Timestamp         string `json:"timestamp"`
+}
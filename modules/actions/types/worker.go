@@ -78,6 +78,37 @@ func (w *ActionsWorker) RegisterHandler(path string, handler ActionHandler) {
 	})
 }
 
+// RegisterPlainHandler registers the provided plaintext handler to be used on each GET call to the provided path
+func (w *ActionsWorker) RegisterPlainHandler(path string, handler PlainHandler) {
+	log.Debug().Str("action", path).Msg("registering plaintext handler")
+	w.mux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+		start := time.Now()
+
+		if request.Method != http.MethodGet {
+			http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		res, err := handler(w.context)
+		if err != nil {
+			logging.ErrorCounter(path)
+			log.Error().Str("action", path).Err(err).Msg("error while executing action")
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		// Prometheus
+		logging.SuccessCounter(path)
+		logging.ReponseTimeBuckets(path, start)
+
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, err = writer.Write([]byte(res))
+		if err != nil {
+			log.Error().Str("action", path).Err(err).Msg("error while writing response")
+		}
+	})
+}
+
 // handleError allows to handle the given error by writing it to the provided writer
 func (w *ActionsWorker) handleError(writer http.ResponseWriter, path string, err error) {
 	log.Error().Str("action", path).
@@ -0,0 +1,21 @@
+package balances
+
+import (
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/njuno/modules/bitcoin"
+	"github.com/forbole/njuno/modules/ibc"
+)
+
+// HandleBlock implements modules.BlockModule.
+// It refreshes the balances of the accounts that receive tokens without being involved in the block messages,
+// i.e. the receivers of the IBC transfers and nBTC deposits, and the refunded senders of the failed IBC transfers
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, _ *tmctypes.ResultValidators,
+) error {
+	addresses := append(
+		ibc.GetTransfersInvolvedAddresses(block, results),
+		bitcoin.GetDepositsReceivers(block, results, m.formats)...,
+	)
+	return m.refreshBalances(addresses, block.Block.Height)
+}
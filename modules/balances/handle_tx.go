@@ -0,0 +1,11 @@
+package balances
+
+import (
+	"github.com/forbole/njuno/modules/messages"
+	"github.com/forbole/njuno/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *types.TxResponse) error {
+	return m.refreshBalances(messages.GetTxInvolvedAddresses(m.cdc, m.parser, tx), tx.Height)
+}
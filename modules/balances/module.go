@@ -0,0 +1,51 @@
+package balances
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/bitcoin"
+	"github.com/forbole/njuno/modules/messages"
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.BlockModule       = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that indexes the balances of the accounts involved in the transactions messages,
+// in the IBC transfers and in the nBTC deposits
+type Module struct {
+	cdc     codec.Marshaler
+	db      database.Database
+	source  node.Node
+	parser  messages.MessageAddressesParser
+	formats *bitcoin.FormatsConfig
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	cfg config.Config, cdc codec.Marshaler, db database.Database, source node.Node, parser messages.MessageAddressesParser,
+) *Module {
+	formats, err := bitcoin.ReadFormatsConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cdc:     cdc,
+		db:      db,
+		source:  source,
+		parser:  parser,
+		formats: formats,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "balances"
+}
@@ -0,0 +1,47 @@
+package balances
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/types"
+)
+
+// refreshBalances reads the balances of the given addresses at the given height and stores them.
+// Empty and duplicated addresses are skipped, while the balances that cannot be read are logged
+func (m *Module) refreshBalances(addresses []string, height int64) error {
+	addresses = uniqueAddresses(addresses)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "balances").Int64("height", height).
+		Int("addresses", len(addresses)).Msg("refreshing accounts balances")
+
+	var balances []types.AccountBalance
+	for _, address := range addresses {
+		balance, err := m.source.AccountBalance(address, height)
+		if err != nil {
+			log.Error().Str("module", "balances").Err(err).Int64("height", height).
+				Str("address", address).Msg("error while getting account balance")
+			continue
+		}
+
+		balances = append(balances, types.NewAccountBalance(address, balance, height))
+	}
+
+	return m.db.SaveAccountBalances(balances)
+}
+
+// uniqueAddresses returns the non empty given addresses without duplicates, preserving their order
+func uniqueAddresses(addresses []string) []string {
+	var unique []string
+	found := map[string]bool{}
+	for _, address := range addresses {
+		if address == "" || found[address] {
+			continue
+		}
+		found[address] = true
+		unique = append(unique, address)
+	}
+	return unique
+}
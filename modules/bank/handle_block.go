@@ -1,6 +1,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -10,6 +12,10 @@ import (
 func (m *Module) HandleBlock(
 	block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ *tmctypes.ResultValidators,
 ) error {
+	if block.Block.Height%m.supply.UpdateInterval() != 0 {
+		return nil
+	}
+
 	err := m.updateSupply(block.Block.Height)
 	if err != nil {
 		log.Error().Str("module", "bank").Int64("height", block.Block.Height).
@@ -19,27 +25,65 @@ func (m *Module) HandleBlock(
 	return nil
 }
 
-// updateSupply updates the supply for a given height
+// updateSupply updates the supply for a given height, storing it only if it changed
+// with respect to the latest stored one.
+// The supply is read from the node only, since the fallback sources of the supply provider
+// contain just the staking denom and would make all the other denoms look removed.
 func (m *Module) updateSupply(height int64) error {
 	log.Debug().Str("module", "bank").Int64("height", height).
 		Msg("updating supply")
 
-	supply, err := m.source.Supply()
+	supply, err := m.source.Supply(height)
+	if err != nil {
+		return fmt.Errorf("error while getting supply from node: %s", err)
+	}
+
+	if supply.Empty() {
+		return fmt.Errorf("empty supply returned by node")
+	}
+
+	// Make sure that concurrent workers do not compute the changes against the same stored supply
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, storedHeight, err := m.db.GetSupply()
 	if err != nil {
 		return err
 	}
 
-	for _, index := range supply {
-		if index.Denom == "unom" {
-			// Hard code total supply of 21M NOM if the total supply
-			// returned from node equals to 1 or less
-			if index.Amount.LTE(sdk.NewInt(1)) {
-				totalSupply := sdk.NewCoin(index.Denom, sdk.NewInt(21000000000000))
-				return m.db.SaveSupply(sdk.NewCoins(totalSupply), height)
+	// Skip if a more recent supply has already been stored
+	if storedHeight > height {
+		return nil
+	}
 
-			}
-		}
+	changes := diffSupply(stored, supply)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	err = m.db.SaveSupplyHistory(changes, height)
+	if err != nil {
+		return fmt.Errorf("error while saving supply history: %s", err)
 	}
 
 	return m.db.SaveSupply(supply, height)
 }
+
+// diffSupply returns the coins of current having an amount different from the stored one,
+// along with a zero coin for each stored denom that is not present anymore
+func diffSupply(stored, current sdk.Coins) []sdk.Coin {
+	var changes []sdk.Coin
+	for _, coin := range current {
+		if !stored.AmountOf(coin.Denom).Equal(coin.Amount) {
+			changes = append(changes, coin)
+		}
+	}
+
+	for _, coin := range stored {
+		if current.AmountOf(coin.Denom).IsZero() {
+			changes = append(changes, sdk.NewCoin(coin.Denom, sdk.ZeroInt()))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,44 @@
+package bank
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSupply(t *testing.T) {
+	stored := sdk.NewCoins(sdk.NewInt64Coin("unom", 100), sdk.NewInt64Coin("usat", 50))
+
+	testCases := []struct {
+		name     string
+		current  sdk.Coins
+		expected []sdk.Coin
+	}{
+		{
+			name:     "unchanged supply",
+			current:  sdk.NewCoins(sdk.NewInt64Coin("unom", 100), sdk.NewInt64Coin("usat", 50)),
+			expected: nil,
+		},
+		{
+			name:     "changed amount",
+			current:  sdk.NewCoins(sdk.NewInt64Coin("unom", 150), sdk.NewInt64Coin("usat", 50)),
+			expected: []sdk.Coin{sdk.NewInt64Coin("unom", 150)},
+		},
+		{
+			name:    "added and removed denoms",
+			current: sdk.NewCoins(sdk.NewInt64Coin("ibc/ABC", 10), sdk.NewInt64Coin("unom", 100)),
+			expected: []sdk.Coin{
+				sdk.NewInt64Coin("ibc/ABC", 10),
+				sdk.NewCoin("usat", sdk.ZeroInt()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffSupply(stored, tc.current))
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package bank
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/forbole/njuno/database"
 	"github.com/forbole/njuno/logging"
 	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/bank/supply"
 	source "github.com/forbole/njuno/node"
 )
 
@@ -20,14 +23,21 @@ type Module struct {
 	db     database.Database
 	logger logging.Logger
 	source source.Node
+	supply *supply.Provider
+
+	// mu makes sure that the supply changes are computed by a single worker at a time
+	mu sync.Mutex
 }
 
-func NewModule(cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node) *Module {
+func NewModule(
+	cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node, supplyProvider *supply.Provider,
+) *Module {
 	return &Module{
 		cdc:    cdc,
 		db:     db,
 		logger: logger,
 		source: source,
+		supply: supplyProvider,
 	}
 }
 
@@ -0,0 +1,98 @@
+package supply
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/node"
+)
+
+// CirculatingCalculator allows to compute the circulating supply of the staking denom, starting from
+// the total supply stored by the bank module and subtracting the balances of the configured addresses
+type CirculatingCalculator struct {
+	cfg  *Config
+	db   database.Database
+	node node.Node
+}
+
+// NewCirculatingCalculator builds a new CirculatingCalculator sharing the supply configuration of the given provider
+func NewCirculatingCalculator(provider *Provider, db database.Database, node node.Node) *CirculatingCalculator {
+	return &CirculatingCalculator{
+		cfg:  provider.cfg,
+		db:   db,
+		node: node,
+	}
+}
+
+// Denom returns the denom whose supply is computed
+func (c *CirculatingCalculator) Denom() string {
+	return c.cfg.Denom
+}
+
+// GetTotalSupply returns the latest total supply of the denom stored inside the database
+func (c *CirculatingCalculator) GetTotalSupply() (sdk.Int, error) {
+	supply, _, err := c.db.GetSupply()
+	if err != nil {
+		return sdk.Int{}, fmt.Errorf("error while getting total supply: %s", err)
+	}
+
+	amount := supply.AmountOf(c.cfg.Denom)
+	if !amount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("no %s total supply found", c.cfg.Denom)
+	}
+
+	return amount, nil
+}
+
+// GetCirculatingSupply returns the total supply of the denom minus the current balances
+// of the locked, vesting, treasury and bridge addresses
+func (c *CirculatingCalculator) GetCirculatingSupply() (sdk.Int, error) {
+	total, err := c.GetTotalSupply()
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	excluded := sdk.ZeroInt()
+	for _, address := range c.cfg.Circulating.GetExcludedAddresses() {
+		balance, err := c.node.AccountBalance(address, 0)
+		if err != nil {
+			return sdk.Int{}, fmt.Errorf("error while getting balance of %s: %s", address, err)
+		}
+		excluded = excluded.Add(balance.AmountOf(c.cfg.Denom))
+	}
+
+	if excluded.GT(total) {
+		return sdk.ZeroInt(), nil
+	}
+
+	return total.Sub(excluded), nil
+}
+
+// FormatAmount returns the given amount converted to a unit having the given exponent,
+// without any trailing zero
+func FormatAmount(amount sdk.Int, exponent int) string {
+	if exponent <= 0 {
+		return amount.String()
+	}
+
+	value := amount.String()
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	if len(value) <= exponent {
+		value = strings.Repeat("0", exponent-len(value)+1) + value
+	}
+
+	integer, decimals := value[:len(value)-exponent], strings.TrimRight(value[len(value)-exponent:], "0")
+	if decimals != "" {
+		integer = integer + "." + decimals
+	}
+
+	if negative {
+		return "-" + integer
+	}
+	return integer
+}
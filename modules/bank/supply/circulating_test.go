@@ -0,0 +1,32 @@
+package supply_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/bank/supply"
+)
+
+func TestCirculatingConfig_GetExcludedAddresses(t *testing.T) {
+	cfg := &supply.CirculatingConfig{
+		LockedAddresses:   []string{"locked", "treasury"},
+		VestingAddresses:  []string{"vesting"},
+		TreasuryAddresses: []string{"treasury", ""},
+		BridgeAddresses:   []string{"bridge"},
+	}
+	require.Equal(t, []string{"locked", "treasury", "vesting", "bridge"}, cfg.GetExcludedAddresses())
+
+	var empty *supply.CirculatingConfig
+	require.Empty(t, empty.GetExcludedAddresses())
+}
+
+func TestFormatAmount(t *testing.T) {
+	require.Equal(t, "21000000", supply.FormatAmount(sdk.NewInt(21000000000000), 6))
+	require.Equal(t, "1.5", supply.FormatAmount(sdk.NewInt(1500000), 6))
+	require.Equal(t, "0.000001", supply.FormatAmount(sdk.NewInt(1), 6))
+	require.Equal(t, "0", supply.FormatAmount(sdk.ZeroInt(), 6))
+	require.Equal(t, "-0.25", supply.FormatAmount(sdk.NewInt(-250000), 6))
+	require.Equal(t, "123", supply.FormatAmount(sdk.NewInt(123), 0))
+}
@@ -0,0 +1,119 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SourceNode    = "node"
+	SourceGenesis = "genesis"
+	SourceConfig  = "config"
+
+	// DefaultUpdateInterval represents the default number of blocks between two supply updates
+	DefaultUpdateInterval = 100
+)
+
+// Config contains the configuration of the total supply sources
+type Config struct {
+	// Sources contains the sources from which the total supply is read, in order of precedence
+	Sources []string `yaml:"sources"`
+
+	// Denom represents the staking denom whose total supply is used to compute the staking pool
+	Denom string `yaml:"denom"`
+
+	// TotalSupply represents the total supply amount of Denom used by the config source
+	TotalSupply string `yaml:"total_supply,omitempty"`
+
+	// UpdateInterval represents the number of blocks between two supply updates
+	UpdateInterval int64 `yaml:"update_interval,omitempty"`
+
+	// Circulating contains the addresses whose balances are subtracted from the total supply
+	// when computing the circulating supply
+	Circulating *CirculatingConfig `yaml:"circulating,omitempty"`
+}
+
+// CirculatingConfig contains the addresses holding tokens that are not considered as circulating
+type CirculatingConfig struct {
+	LockedAddresses   []string `yaml:"locked_addresses,omitempty"`
+	VestingAddresses  []string `yaml:"vesting_addresses,omitempty"`
+	TreasuryAddresses []string `yaml:"treasury_addresses,omitempty"`
+	BridgeAddresses   []string `yaml:"bridge_addresses,omitempty"`
+}
+
+// GetExcludedAddresses returns all the configured addresses, without duplicates
+func (c *CirculatingConfig) GetExcludedAddresses() []string {
+	if c == nil {
+		return nil
+	}
+
+	var addresses []string
+	found := map[string]bool{}
+	for _, group := range [][]string{c.LockedAddresses, c.VestingAddresses, c.TreasuryAddresses, c.BridgeAddresses} {
+		for _, address := range group {
+			if address == "" || found[address] {
+				continue
+			}
+			found[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// NewConfig allows to build a new Config instance
+func NewConfig(sources []string, denom, totalSupply string) *Config {
+	return &Config{
+		Sources:        sources,
+		Denom:          denom,
+		TotalSupply:    totalSupply,
+		UpdateInterval: DefaultUpdateInterval,
+	}
+}
+
+// DefaultConfig returns the default supply configuration, which reads the supply from the node
+// falling back to the genesis file
+func DefaultConfig() *Config {
+	return NewConfig([]string{SourceNode, SourceGenesis}, "unom", "")
+}
+
+// Validate checks whether the configuration is valid
+func (c *Config) Validate() error {
+	if len(c.Sources) == 0 {
+		return fmt.Errorf("no supply sources specified")
+	}
+
+	for _, source := range c.Sources {
+		if source != SourceNode && source != SourceGenesis && source != SourceConfig {
+			return fmt.Errorf("invalid supply source: %s", source)
+		}
+
+		if source == SourceConfig {
+			if _, ok := sdk.NewIntFromString(c.TotalSupply); !ok {
+				return fmt.Errorf("invalid total supply value required by the %s source: %s", SourceConfig, c.TotalSupply)
+			}
+		}
+	}
+
+	if c.Denom == "" {
+		return fmt.Errorf("missing supply denom")
+	}
+
+	if c.UpdateInterval < 0 {
+		return fmt.Errorf("invalid supply update interval: %d", c.UpdateInterval)
+	}
+
+	return nil
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"supply"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -0,0 +1,59 @@
+package supply_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/bank/supply"
+)
+
+func TestParseConfig(t *testing.T) {
+	data := []byte(`
+supply:
+  sources:
+    - config
+    - node
+  denom: unom
+  total_supply: "21000000000000"
+  update_interval: 50
+  circulating:
+    locked_addresses:
+      - nomic1locked
+    bridge_addresses:
+      - nomic1bridge
+`)
+
+	cfg, err := supply.ParseConfig(data)
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg)
+	require.Equal(t, []string{supply.SourceConfig, supply.SourceNode}, cfg.Sources)
+	require.Equal(t, "unom", cfg.Denom)
+	require.Equal(t, "21000000000000", cfg.TotalSupply)
+	require.Equal(t, int64(50), cfg.UpdateInterval)
+	require.Equal(t, []string{"nomic1locked", "nomic1bridge"}, cfg.Circulating.GetExcludedAddresses())
+	require.NoError(t, cfg.Validate())
+
+	cfg.UpdateInterval = -1
+	require.Error(t, cfg.Validate())
+	cfg.UpdateInterval = 50
+
+	cfg.TotalSupply = ""
+	require.Error(t, cfg.Validate())
+	cfg.TotalSupply = "21000000000000"
+
+	cfg.Sources = []string{"invalid"}
+	require.Error(t, cfg.Validate())
+
+	data = []byte(`invalid_field: yes`)
+	cfg, err = supply.ParseConfig(data)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+}
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := supply.DefaultConfig()
+	require.Equal(t, []string{supply.SourceNode, supply.SourceGenesis}, cfg.Sources)
+	require.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,141 @@
+package supply
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
+	"github.com/forbole/njuno/types/utils"
+)
+
+// Provider allows to read the total supply from the configured sources, in order of precedence
+type Provider struct {
+	cfg  *Config
+	node node.Node
+
+	genesisOnce   sync.Once
+	genesisSupply sdk.Coins
+	genesisErr    error
+}
+
+// NewProvider builds a new Provider reading the supply configuration from the given config
+func NewProvider(cfg config.Config, node node.Node) (*Provider, error) {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	supplyCfg, err := ParseConfig(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	if supplyCfg == nil {
+		supplyCfg = DefaultConfig()
+	}
+
+	err = supplyCfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		cfg:  supplyCfg,
+		node: node,
+	}, nil
+}
+
+// Denom returns the staking denom
+func (p *Provider) Denom() string {
+	return p.cfg.Denom
+}
+
+// UpdateInterval returns the number of blocks between two supply updates
+func (p *Provider) UpdateInterval() int64 {
+	if p.cfg.UpdateInterval <= 0 {
+		return DefaultUpdateInterval
+	}
+	return p.cfg.UpdateInterval
+}
+
+// GetTotalSupply returns the total supply read from the first source that returns a valid value.
+// A value is considered valid when the staking denom amount is greater than 1.
+func (p *Provider) GetTotalSupply() (sdk.Coins, error) {
+	for _, source := range p.cfg.Sources {
+		supply, err := p.getSupplyFromSource(source)
+		if err != nil {
+			log.Debug().Str("module", "bank").Str("source", source).Err(err).Msg("skipping supply source")
+			continue
+		}
+
+		if supply.AmountOf(p.cfg.Denom).GT(sdk.OneInt()) {
+			return supply, nil
+		}
+
+		log.Debug().Str("module", "bank").Str("source", source).Msg("skipping invalid supply value")
+	}
+
+	return nil, fmt.Errorf("no valid %s total supply found within sources %v", p.cfg.Denom, p.cfg.Sources)
+}
+
+// getSupplyFromSource returns the total supply read from the given source
+func (p *Provider) getSupplyFromSource(source string) (sdk.Coins, error) {
+	switch source {
+	case SourceNode:
+		return p.node.Supply(0)
+
+	case SourceGenesis:
+		p.genesisOnce.Do(func() {
+			p.genesisSupply, p.genesisErr = p.getGenesisSupply()
+		})
+		return p.genesisSupply, p.genesisErr
+
+	case SourceConfig:
+		amount, ok := sdk.NewIntFromString(p.cfg.TotalSupply)
+		if !ok {
+			return nil, fmt.Errorf("invalid total supply value: %s", p.cfg.TotalSupply)
+		}
+		return sdk.NewCoins(sdk.NewCoin(p.cfg.Denom, amount)), nil
+
+	default:
+		return nil, fmt.Errorf("invalid supply source: %s", source)
+	}
+}
+
+// getGenesisSupply returns the supply contained inside the bank genesis state. If no supply is set,
+// it is computed by summing all the genesis balances instead
+func (p *Provider) getGenesisSupply() (sdk.Coins, error) {
+	genesisDoc, err := utils.GetGenesisDoc(config.Cfg.Parser.GenesisFilePath, p.node)
+	if err != nil {
+		return nil, err
+	}
+
+	var appState struct {
+		Bank struct {
+			Supply   sdk.Coins `json:"supply"`
+			Balances []struct {
+				Coins sdk.Coins `json:"coins"`
+			} `json:"balances"`
+		} `json:"bank"`
+	}
+	err = json.Unmarshal(genesisDoc.AppState, &appState)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling genesis app state: %s", err)
+	}
+
+	if !appState.Bank.Supply.Empty() {
+		return appState.Bank.Supply, nil
+	}
+
+	supply := sdk.NewCoins()
+	for _, balance := range appState.Bank.Balances {
+		supply = supply.Add(balance.Coins...)
+	}
+
+	return supply, nil
+}
@@ -0,0 +1,103 @@
+package bitcoin
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/njuno/modules/bitcoin/source"
+	"github.com/forbole/njuno/types/config"
+)
+
+// Config contains the configuration of the bitcoin module
+type Config struct {
+	// Source contains the configuration of the source used to read the checkpoints.
+	// When it is not set, the checkpoints are not indexed
+	Source *source.Config `yaml:"source,omitempty"`
+
+	// Interval represents the time between two checkpoints updates
+	Interval time.Duration `yaml:"interval,omitempty"`
+
+	// Formats contains the message and event formats used to index the deposits and withdrawals
+	Formats *FormatsConfig `yaml:"formats,omitempty"`
+}
+
+// FormatsConfig contains the type of the withdrawal messages, and the type and attribute keys of the deposit events.
+// NOTE. There are no default values since the formats used by the Nomic sources (github.com/nomic-io/nomic) have not
+// been verified yet: they must be read from the transactions and events of the indexed chain and set explicitly.
+// The withdrawals are indexed only when the message type is set, and the deposits only when the event type is set
+type FormatsConfig struct {
+	WithdrawMsgType          string `yaml:"withdraw_msg_type,omitempty"`
+	DepositEventType         string `yaml:"deposit_event_type,omitempty"`
+	DepositTxidAttribute     string `yaml:"deposit_txid_attribute,omitempty"`
+	DepositVoutAttribute     string `yaml:"deposit_vout_attribute,omitempty"`
+	DepositReceiverAttribute string `yaml:"deposit_receiver_attribute,omitempty"`
+	DepositAmountAttribute   string `yaml:"deposit_amount_attribute,omitempty"`
+}
+
+// Validate checks whether the formats are valid
+func (f *FormatsConfig) Validate() error {
+	if f == nil || f.DepositEventType == "" {
+		return nil
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value string
+	}{
+		{"txid", f.DepositTxidAttribute},
+		{"vout", f.DepositVoutAttribute},
+		{"receiver", f.DepositReceiverAttribute},
+		{"amount", f.DepositAmountAttribute},
+	} {
+		if attribute.value == "" {
+			return fmt.Errorf("missing deposit %s attribute of the bitcoin formats", attribute.name)
+		}
+	}
+	return nil
+}
+
+// IndexesWithdrawals tells whether the withdrawal messages type is configured
+func (f *FormatsConfig) IndexesWithdrawals() bool {
+	return f != nil && f.WithdrawMsgType != ""
+}
+
+// IndexesDeposits tells whether the deposit events type is configured
+func (f *FormatsConfig) IndexesDeposits() bool {
+	return f != nil && f.DepositEventType != ""
+}
+
+// ReadFormatsConfig returns the formats contained inside the bitcoin configuration of the given config,
+// or nil if they are not set
+func ReadFormatsConfig(cfg config.Config) (*FormatsConfig, error) {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	bitcoinCfg, err := ParseConfig(bz)
+	if err != nil || bitcoinCfg == nil {
+		return nil, err
+	}
+
+	return bitcoinCfg.Formats, bitcoinCfg.Formats.Validate()
+}
+
+// DefaultConfig returns the default bitcoin configuration. Since reading the checkpoints requires an external
+// source (e.g. the nomic CLI) and the formats of the deposits and withdrawals are chain specific,
+// no source and no formats are set, so nothing is indexed by default
+func DefaultConfig() *Config {
+	return &Config{
+		Interval: 10 * time.Minute,
+	}
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"bitcoin"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -0,0 +1,16 @@
+package bitcoin
+
+import (
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, _ *tmctypes.ResultValidators,
+) error {
+	if results == nil || !m.cfg.Formats.IndexesDeposits() {
+		return nil
+	}
+
+	return m.db.SaveBtcDeposits(parseBlockDeposits(block, results, m.cfg.Formats))
+}
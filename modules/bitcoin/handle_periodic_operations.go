@@ -0,0 +1,54 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	// Warn about the missing formats here, since this is only called when the module is enabled
+	if !m.cfg.Formats.IndexesDeposits() {
+		log.Warn().Str("module", "bitcoin").Msg("no deposit event type configured, skipping deposits indexing")
+	}
+	if !m.cfg.Formats.IndexesWithdrawals() {
+		log.Warn().Str("module", "bitcoin").Msg("no withdraw message type configured, skipping withdrawals indexing")
+	}
+
+	if m.source == nil {
+		log.Debug().Str("module", "bitcoin").Msg("no bridge source configured, skipping checkpoints updates")
+		return nil
+	}
+
+	log.Debug().Str("module", "bitcoin").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(m.cfg.Interval).Do(func() {
+		utils.WatchMethod(m.UpdateCheckpoints)
+	}); err != nil {
+		return fmt.Errorf("error while setting up bitcoin periodic operations: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateCheckpoints reads the latest checkpoints from the bridge source and stores them,
+// along with their signatory set, associated with the latest block height
+func (m *Module) UpdateCheckpoints() error {
+	log.Debug().Str("module", "bitcoin").Msg("updating checkpoints")
+
+	block, err := m.db.GetLastBlock()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block: %s", err)
+	}
+
+	checkpoints, err := m.source.GetCheckpoints()
+	if err != nil {
+		return fmt.Errorf("error while getting checkpoints: %s", err)
+	}
+
+	return m.db.SaveBtcCheckpoints(checkpoints, block.Height)
+}
@@ -0,0 +1,14 @@
+package bitcoin
+
+import (
+	"github.com/forbole/njuno/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *types.TxResponse) error {
+	if !m.cfg.Formats.IndexesWithdrawals() {
+		return nil
+	}
+
+	return m.db.SaveBtcWithdrawals(parseWithdrawals(tx, m.cfg.Formats.WithdrawMsgType))
+}
@@ -0,0 +1,73 @@
+package bitcoin
+
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/bitcoin/source"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.BlockModule              = &Module{}
+	_ modules.TransactionModule        = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that indexes the deposits, withdrawals and checkpoints of the nBTC bridge
+type Module struct {
+	cfg    *Config
+	db     database.Database
+	source source.BridgeSource
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db database.Database) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	bitcoinCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if bitcoinCfg == nil {
+		bitcoinCfg = DefaultConfig()
+	}
+	if bitcoinCfg.Interval <= 0 {
+		bitcoinCfg.Interval = DefaultConfig().Interval
+	}
+
+	err = bitcoinCfg.Formats.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	// The checkpoints are indexed only when a source is explicitly configured
+	var bridgeSource source.BridgeSource
+	if bitcoinCfg.Source != nil {
+		bridgeSource, err = source.NewBridgeSource(bitcoinCfg.Source)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return NewModuleWithSource(bitcoinCfg, db, bridgeSource)
+}
+
+// NewModuleWithSource builds a new Module instance reading the bridge state from the given source.
+// If the source is nil, the checkpoints are not indexed
+func NewModuleWithSource(cfg *Config, db database.Database, source source.BridgeSource) *Module {
+	return &Module{
+		cfg:    cfg,
+		db:     db,
+		source: source,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "bitcoin"
+}
@@ -0,0 +1,48 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ BridgeSource = &HTTPSource{}
+)
+
+// HTTPSource represents a BridgeSource that reads the bridge state from an HTTP endpoint
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSource builds a new HTTPSource instance
+func NewHTTPSource(url string, timeout time.Duration) *HTTPSource {
+	return &HTTPSource{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// GetCheckpoints implements BridgeSource
+func (s *HTTPSource) GetCheckpoints() ([]types.BtcCheckpoint, error) {
+	resp, err := s.client.Get(s.url)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying checkpoints endpoint: %s", err)
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading checkpoints response body: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("checkpoints endpoint returned status %d: %s", resp.StatusCode, bz)
+	}
+
+	return parseCheckpoints(bz)
+}
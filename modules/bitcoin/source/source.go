@@ -0,0 +1,79 @@
+package source
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	TypeCLI  = "cli"
+	TypeHTTP = "http"
+	TypeStub = "stub"
+)
+
+// BridgeSource represents a source from which the state of the nBTC bridge can be read
+type BridgeSource interface {
+	// GetCheckpoints returns the latest checkpoints of the bridge, along with their signatory set.
+	// An error is returned if the checkpoints cannot be read.
+	GetCheckpoints() ([]types.BtcCheckpoint, error)
+}
+
+// Config contains the configuration of the source used to read the bridge state.
+// The command output or the endpoint response must be a YAML or JSON checkpoints list (see parseCheckpoints).
+// NOTE. No command of the Nomic CLI (github.com/nomic-io/nomic) has been verified to print such list yet
+type Config struct {
+	Type    string        `yaml:"type"`
+	Command string        `yaml:"command,omitempty"`
+	Args    []string      `yaml:"args,omitempty"`
+	URL     string        `yaml:"url,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NewBridgeSource builds the BridgeSource instance described by the given configuration
+func NewBridgeSource(cfg *Config) (BridgeSource, error) {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	switch cfg.Type {
+	case TypeCLI:
+		if cfg.Command == "" {
+			return nil, fmt.Errorf("missing command of the bridge cli source")
+		}
+		return NewCLISource(cfg.Command, cfg.Args, timeout), nil
+
+	case TypeHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("missing url of the bridge http source")
+		}
+		return NewHTTPSource(cfg.URL, timeout), nil
+
+	case TypeStub:
+		return NewStubSource(), nil
+
+	default:
+		return nil, fmt.Errorf("invalid bridge source type: %s", cfg.Type)
+	}
+}
+
+// parseCheckpoints parses the given YAML (or JSON) checkpoints list.
+// Both a list wrapped inside the checkpoints field and a bare list are supported.
+func parseCheckpoints(bz []byte) ([]types.BtcCheckpoint, error) {
+	var checkpoints types.BtcCheckpoints
+	err := yaml.Unmarshal(bz, &checkpoints)
+	if err != nil {
+		// Try parsing the bare list
+		var list []types.BtcCheckpoint
+		if yaml.Unmarshal(bz, &list) != nil {
+			return nil, fmt.Errorf("error while unmarshaling checkpoints: %s", err)
+		}
+		checkpoints.Checkpoints = list
+	}
+
+	return checkpoints.Checkpoints, nil
+}
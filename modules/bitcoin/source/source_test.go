@@ -0,0 +1,102 @@
+package source_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/bitcoin/source"
+	"github.com/forbole/njuno/types"
+)
+
+// requireCheckpoints checks that the given checkpoints match the content of the checkpoints test file
+func requireCheckpoints(t *testing.T, checkpoints []types.BtcCheckpoint) {
+	require.Len(t, checkpoints, 2)
+
+	require.Equal(t, uint32(41), checkpoints[0].Index)
+	require.Equal(t, types.BtcCheckpointStatusComplete, checkpoints[0].Status)
+	require.Equal(t, "4e6f2b1c1d3a9f0e7b8c5d2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e", checkpoints[0].Txid)
+	require.Equal(t, uint64(40), checkpoints[0].FeeRate)
+	require.Equal(t, int64(1667260800), checkpoints[0].SignatorySet.CreateTime)
+	require.Len(t, checkpoints[0].SignatorySet.Signatories, 2)
+	require.Equal(t, uint64(1500), checkpoints[0].SignatorySet.Signatories[0].VotingPower)
+
+	require.Equal(t, types.BtcCheckpointStatusBuilding, checkpoints[1].Status)
+	require.Empty(t, checkpoints[1].Txid)
+}
+
+func TestCLISource_GetCheckpoints(t *testing.T) {
+	src := source.NewCLISource("cat", []string{filepath.Join("testdata", "checkpoints.json")}, time.Second)
+	checkpoints, err := src.GetCheckpoints()
+	require.NoError(t, err)
+	requireCheckpoints(t, checkpoints)
+
+	src = source.NewCLISource("sleep", []string{"5"}, 100*time.Millisecond)
+	_, err = src.GetCheckpoints()
+	require.Error(t, err)
+
+	src = source.NewCLISource("false", nil, time.Second)
+	_, err = src.GetCheckpoints()
+	require.Error(t, err)
+}
+
+func TestHTTPSource_GetCheckpoints(t *testing.T) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "checkpoints.json"))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/checkpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(bz)
+	}))
+	defer server.Close()
+
+	src := source.NewHTTPSource(server.URL+"/checkpoints", time.Second)
+	checkpoints, err := src.GetCheckpoints()
+	require.NoError(t, err)
+	requireCheckpoints(t, checkpoints)
+
+	src = source.NewHTTPSource(server.URL+"/invalid", time.Second)
+	_, err = src.GetCheckpoints()
+	require.Error(t, err)
+}
+
+func TestStubSource_GetCheckpoints(t *testing.T) {
+	src := source.NewStubSource()
+	checkpoints, err := src.GetCheckpoints()
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+
+	checkpoint := types.BtcCheckpoint{Index: 1, Status: types.BtcCheckpointStatusSigning}
+	src.SetCheckpoints(checkpoint)
+	checkpoints, err = src.GetCheckpoints()
+	require.NoError(t, err)
+	require.Equal(t, []types.BtcCheckpoint{checkpoint}, checkpoints)
+}
+
+func TestNewBridgeSource(t *testing.T) {
+	src, err := source.NewBridgeSource(&source.Config{Type: source.TypeCLI, Command: "checkpoints"})
+	require.NoError(t, err)
+	require.IsType(t, &source.CLISource{}, src)
+
+	src, err = source.NewBridgeSource(&source.Config{Type: source.TypeHTTP, URL: "http://localhost"})
+	require.NoError(t, err)
+	require.IsType(t, &source.HTTPSource{}, src)
+
+	src, err = source.NewBridgeSource(&source.Config{Type: source.TypeStub})
+	require.NoError(t, err)
+	require.IsType(t, &source.StubSource{}, src)
+
+	_, err = source.NewBridgeSource(&source.Config{Type: source.TypeHTTP})
+	require.Error(t, err)
+
+	_, err = source.NewBridgeSource(&source.Config{Type: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package source
+
+import (
+	"sync"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ BridgeSource = &StubSource{}
+)
+
+// StubSource represents an in-memory BridgeSource, which allows to run the bitcoin module offline
+type StubSource struct {
+	mu          sync.RWMutex
+	checkpoints []types.BtcCheckpoint
+}
+
+// NewStubSource builds a new StubSource instance returning the given checkpoints
+func NewStubSource(checkpoints ...types.BtcCheckpoint) *StubSource {
+	return &StubSource{
+		checkpoints: checkpoints,
+	}
+}
+
+// SetCheckpoints replaces the checkpoints returned by the source
+func (s *StubSource) SetCheckpoints(checkpoints ...types.BtcCheckpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints = checkpoints
+}
+
+// GetCheckpoints implements BridgeSource
+func (s *StubSource) GetCheckpoints() ([]types.BtcCheckpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.checkpoints, nil
+}
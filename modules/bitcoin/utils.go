@@ -0,0 +1,116 @@
+package bitcoin
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// parseWithdrawals returns the withdrawals contained inside the messages of the given transaction
+// having the given type
+func parseWithdrawals(tx *types.TxResponse, msgType string) []types.BtcWithdrawal {
+	var withdrawals []types.BtcWithdrawal
+	for index, msg := range tx.Msg {
+		if msg.Type != msgType {
+			continue
+		}
+
+		withdrawals = append(withdrawals, types.NewBtcWithdrawal(
+			tx.Hash, index, msg.Value.FromAddress, msg.Value.DstAddress, msg.Value.Amount, tx.Height,
+		))
+	}
+	return withdrawals
+}
+
+// parseBlockDeposits returns the deposits contained inside the events of the given block results having
+// the given formats
+func parseBlockDeposits(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, formats *FormatsConfig,
+) []types.BtcDeposit {
+	height := block.Block.Height
+
+	// Deposits can be relayed both by transactions and by the chain itself
+	deposits := parseDepositEvents(results.BeginBlockEvents, formats, "", height)
+
+	for index, txResult := range results.TxsResults {
+		var txHash string
+		if index < len(block.Block.Txs) {
+			txHash = fmt.Sprintf("%X", block.Block.Txs[index].Hash())
+		}
+
+		deposits = append(deposits, parseDepositEvents(txResult.Events, formats, txHash, height)...)
+	}
+
+	return append(deposits, parseDepositEvents(results.EndBlockEvents, formats, "", height)...)
+}
+
+// GetDepositsReceivers returns the receivers of the deposits contained inside the given block results
+// having the given formats. If the deposits formats are not configured, nil is returned
+func GetDepositsReceivers(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, formats *FormatsConfig,
+) []string {
+	if results == nil || !formats.IndexesDeposits() {
+		return nil
+	}
+
+	var receivers []string
+	for _, deposit := range parseBlockDeposits(block, results, formats) {
+		receivers = append(receivers, deposit.ReceiverAddress)
+	}
+	return receivers
+}
+
+// parseDepositEvents returns the deposits contained inside the given events having the given formats,
+// which have been emitted by the transaction having the given hash, if any.
+// Malformed deposit events are logged and skipped
+func parseDepositEvents(events []abci.Event, formats *FormatsConfig, txHash string, height int64) []types.BtcDeposit {
+	var deposits []types.BtcDeposit
+	for _, event := range events {
+		if event.Type != formats.DepositEventType {
+			continue
+		}
+
+		deposit, err := parseDepositEvent(event, formats, txHash, height)
+		if err != nil {
+			log.Error().Str("module", "bitcoin").Err(err).Int64("height", height).Str("tx_hash", txHash).
+				Msg("skipping malformed deposit event")
+			continue
+		}
+
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// parseDepositEvent returns the deposit contained inside the given event
+func parseDepositEvent(event abci.Event, formats *FormatsConfig, txHash string, height int64) (types.BtcDeposit, error) {
+	attributes := map[string]string{}
+	for _, attr := range event.Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+
+	txid := attributes[formats.DepositTxidAttribute]
+	if txid == "" {
+		return types.BtcDeposit{}, fmt.Errorf("missing deposit txid")
+	}
+
+	vout, err := strconv.ParseUint(attributes[formats.DepositVoutAttribute], 10, 32)
+	if err != nil {
+		return types.BtcDeposit{}, fmt.Errorf("invalid deposit vout: %s", attributes[formats.DepositVoutAttribute])
+	}
+
+	amount, ok := sdk.NewIntFromString(attributes[formats.DepositAmountAttribute])
+	if !ok {
+		return types.BtcDeposit{}, fmt.Errorf("invalid deposit amount: %s", attributes[formats.DepositAmountAttribute])
+	}
+
+	return types.NewBtcDeposit(
+		txid, uint32(vout), attributes[formats.DepositReceiverAttribute], amount, txHash, height,
+	), nil
+}
@@ -0,0 +1,144 @@
+package bitcoin
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// testFormats contains the formats used by the tests. These are arbitrary values, since the formats used
+// by the Nomic sources have not been verified yet
+var testFormats = &FormatsConfig{
+	WithdrawMsgType:          "nbtc/MsgWithdraw",
+	DepositEventType:         "nbtc_deposit",
+	DepositTxidAttribute:     "txid",
+	DepositVoutAttribute:     "vout",
+	DepositReceiverAttribute: "receiver",
+	DepositAmountAttribute:   "amount",
+}
+
+func TestParseWithdrawals(t *testing.T) {
+	tx := &types.TxResponse{
+		Hash:   "HASH",
+		Height: 10,
+		Msg: []types.TxMsg{
+			{Type: "cosmos-sdk/MsgDelegate"},
+			{Type: testFormats.WithdrawMsgType, Value: types.TxMsgValue{
+				FromAddress: "nomic1sender",
+				DstAddress:  "bc1qdestination",
+				Amount:      sdk.NewInt64Coin("usat", 1000),
+			}},
+		},
+	}
+
+	withdrawals := parseWithdrawals(tx, testFormats.WithdrawMsgType)
+	require.Equal(t, []types.BtcWithdrawal{
+		types.NewBtcWithdrawal("HASH", 1, "nomic1sender", "bc1qdestination", sdk.NewInt64Coin("usat", 1000), 10),
+	}, withdrawals)
+}
+
+func TestParseDepositEvents(t *testing.T) {
+	events := []abci.Event{
+		{Type: "transfer"},
+		{Type: testFormats.DepositEventType, Attributes: []abci.EventAttribute{
+			{Key: []byte(testFormats.DepositTxidAttribute), Value: []byte("txid")},
+			{Key: []byte(testFormats.DepositVoutAttribute), Value: []byte("1")},
+			{Key: []byte(testFormats.DepositReceiverAttribute), Value: []byte("nomic1receiver")},
+			{Key: []byte(testFormats.DepositAmountAttribute), Value: []byte("250000")},
+		}},
+	}
+
+	formats := testFormats
+	deposits := parseDepositEvents(events, formats, "HASH", 10)
+	require.Equal(t, []types.BtcDeposit{
+		types.NewBtcDeposit("txid", 1, "nomic1receiver", sdk.NewInt(250000), "HASH", 10),
+	}, deposits)
+
+	// Malformed events are skipped without affecting the valid ones
+	malformed := abci.Event{Type: testFormats.DepositEventType, Attributes: []abci.EventAttribute{
+		{Key: []byte(testFormats.DepositTxidAttribute), Value: []byte("txid2")},
+		{Key: []byte(testFormats.DepositVoutAttribute), Value: []byte("0")},
+		{Key: []byte(testFormats.DepositAmountAttribute), Value: []byte("invalid")},
+	}}
+	deposits = parseDepositEvents(append([]abci.Event{malformed}, events...), formats, "HASH", 10)
+	require.Equal(t, []types.BtcDeposit{
+		types.NewBtcDeposit("txid", 1, "nomic1receiver", sdk.NewInt(250000), "HASH", 10),
+	}, deposits)
+
+	// The configured formats are used to find the deposit events
+	custom := *testFormats
+	custom.DepositEventType, custom.DepositAmountAttribute = "deposit", "value"
+	require.Empty(t, parseDepositEvents(events, &custom, "HASH", 10))
+
+	customEvent := abci.Event{Type: "deposit", Attributes: []abci.EventAttribute{
+		{Key: []byte(testFormats.DepositTxidAttribute), Value: []byte("txid3")},
+		{Key: []byte(testFormats.DepositVoutAttribute), Value: []byte("2")},
+		{Key: []byte("value"), Value: []byte("100")},
+	}}
+	require.Equal(t, []types.BtcDeposit{
+		types.NewBtcDeposit("txid3", 2, "", sdk.NewInt(100), "", 10),
+	}, parseDepositEvents([]abci.Event{customEvent}, &custom, "", 10))
+}
+
+func TestGetDepositsReceivers(t *testing.T) {
+	depositEvent := func(txid, receiver string) abci.Event {
+		return abci.Event{Type: testFormats.DepositEventType, Attributes: []abci.EventAttribute{
+			{Key: []byte(testFormats.DepositTxidAttribute), Value: []byte(txid)},
+			{Key: []byte(testFormats.DepositVoutAttribute), Value: []byte("0")},
+			{Key: []byte(testFormats.DepositReceiverAttribute), Value: []byte(receiver)},
+			{Key: []byte(testFormats.DepositAmountAttribute), Value: []byte("1000")},
+		}}
+	}
+
+	block := &tmctypes.ResultBlock{Block: &tmtypes.Block{
+		Header: tmtypes.Header{Height: 10},
+		Data:   tmtypes.Data{Txs: tmtypes.Txs{[]byte("tx")}},
+	}}
+	results := &tmctypes.ResultBlockResults{
+		BeginBlockEvents: []abci.Event{depositEvent("txid1", "nomic1begin")},
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{depositEvent("txid2", "nomic1tx")}},
+		},
+		EndBlockEvents: []abci.Event{depositEvent("txid3", "nomic1end")},
+	}
+
+	receivers := GetDepositsReceivers(block, results, testFormats)
+	require.Equal(t, []string{"nomic1begin", "nomic1tx", "nomic1end"}, receivers)
+	require.Empty(t, GetDepositsReceivers(block, nil, testFormats))
+	require.Empty(t, GetDepositsReceivers(block, results, nil))
+}
+
+func TestFormatsConfig_Validate(t *testing.T) {
+	var formats *FormatsConfig
+	require.NoError(t, formats.Validate())
+	require.False(t, formats.IndexesDeposits())
+	require.False(t, formats.IndexesWithdrawals())
+
+	require.NoError(t, testFormats.Validate())
+	require.True(t, testFormats.IndexesDeposits())
+	require.True(t, testFormats.IndexesWithdrawals())
+
+	// The withdrawals can be indexed without the deposits
+	require.NoError(t, (&FormatsConfig{WithdrawMsgType: "nbtc/MsgWithdraw"}).Validate())
+
+	// The deposits require all the attributes to be set
+	require.Error(t, (&FormatsConfig{DepositEventType: "nbtc_deposit"}).Validate())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Nil(t, cfg.Source)
+	require.Nil(t, cfg.Formats)
+
+	// Without formats and source nothing is indexed, so the database is never used
+	module := NewModuleWithSource(&Config{}, nil, nil)
+	require.NoError(t, module.RegisterPeriodicOperations(nil))
+	require.NoError(t, module.HandleTx(&types.TxResponse{}))
+	require.NoError(t, module.HandleBlock(nil, &tmctypes.ResultBlockResults{}, nil))
+}
@@ -0,0 +1,69 @@
+package decentralization
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "decentralization").Msg("setting up periodic tasks")
+
+	// Update the metrics every 5 mins, following the validators voting power updates
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.UpdateDecentralizationMetrics)
+	}); err != nil {
+		return fmt.Errorf("error while setting up decentralization periodic operations: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateDecentralizationMetrics computes the decentralization metrics using the stored validators
+// voting power and status, and stores them associated with the latest block
+func (m *Module) UpdateDecentralizationMetrics() error {
+	log.Debug().Str("module", "decentralization").Msg("updating decentralization metrics")
+
+	block, err := m.db.GetLastBlock()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block: %s", err)
+	}
+
+	votingPowers, err := m.db.GetValidatorsVotingPower()
+	if err != nil {
+		return fmt.Errorf("error while getting validators voting power: %s", err)
+	}
+
+	statuses, err := m.db.GetValidatorsStatus()
+	if err != nil {
+		return fmt.Errorf("error while getting validators status: %s", err)
+	}
+
+	// Consider only the validators inside the active set, if their status is known
+	active := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		active[status.SelfDelegateAddress] = status.InActiveSet == "true"
+	}
+
+	var powers []sdk.Int
+	for _, votingPower := range votingPowers {
+		if isActive, found := active[votingPower.SelfDelegateAddress]; found && !isActive {
+			continue
+		}
+
+		power, ok := sdk.NewIntFromString(votingPower.VotingPower)
+		if !ok {
+			return fmt.Errorf("invalid voting power of validator %s: %s",
+				votingPower.SelfDelegateAddress, votingPower.VotingPower)
+		}
+		powers = append(powers, power)
+	}
+
+	metrics := ComputeMetrics(powers, block.Height, block.Timestamp)
+	return m.db.SaveDecentralizationMetrics(metrics)
+}
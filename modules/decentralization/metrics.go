@@ -0,0 +1,93 @@
+package decentralization
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// ComputeMetrics computes the decentralization metrics of the given active set voting powers.
+// Validators having no voting power are not considered part of the active set.
+func ComputeMetrics(votingPowers []sdk.Int, height int64, timestamp time.Time) types.DecentralizationMetrics {
+	var powers []sdk.Int
+	total := sdk.ZeroInt()
+	for _, power := range votingPowers {
+		if !power.IsPositive() {
+			continue
+		}
+		powers = append(powers, power)
+		total = total.Add(power)
+	}
+
+	// Sort the voting powers from the highest to the lowest
+	sort.Slice(powers, func(i, j int) bool {
+		return powers[i].GT(powers[j])
+	})
+
+	return types.NewDecentralizationMetrics(
+		nakamotoCoefficient(powers, total, 1, 3),
+		nakamotoCoefficient(powers, total, 2, 3),
+		giniCoefficient(powers, total),
+		topShare(powers, total, 10),
+		topShare(powers, total, 20),
+		int64(len(powers)),
+		total,
+		height,
+		timestamp,
+	)
+}
+
+// nakamotoCoefficient returns the minimum number of validators whose combined voting power is greater than
+// the num/den fraction of the total one. The given powers must be sorted from the highest to the lowest.
+func nakamotoCoefficient(powers []sdk.Int, total sdk.Int, num, den int64) int64 {
+	threshold := total.MulRaw(num)
+	sum := sdk.ZeroInt()
+	for i, power := range powers {
+		sum = sum.Add(power)
+		if sum.MulRaw(den).GT(threshold) {
+			return int64(i + 1)
+		}
+	}
+	return 0
+}
+
+// giniCoefficient returns the Gini coefficient of the given voting powers, where 0 represents a perfectly even
+// distribution and values close to 1 a distribution concentrated on a single validator.
+// The given powers must be sorted from the highest to the lowest.
+func giniCoefficient(powers []sdk.Int, total sdk.Int) sdk.Dec {
+	n := int64(len(powers))
+	if n == 0 || total.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	// G = (2 * sum(i * x_i) - (n + 1) * sum(x_i)) / (n * sum(x_i)), with x_i sorted ascending and i starting from 1
+	weighted := sdk.ZeroInt()
+	for i, power := range powers {
+		rank := n - int64(i)
+		weighted = weighted.Add(power.MulRaw(rank))
+	}
+
+	numerator := weighted.MulRaw(2).Sub(total.MulRaw(n + 1))
+	gini := numerator.ToDec().Quo(total.MulRaw(n).ToDec())
+	if gini.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return gini
+}
+
+// topShare returns the share of the total voting power owned by the given number of top validators.
+// The given powers must be sorted from the highest to the lowest.
+func topShare(powers []sdk.Int, total sdk.Int, count int) sdk.Dec {
+	if total.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	sum := sdk.ZeroInt()
+	for i := 0; i < count && i < len(powers); i++ {
+		sum = sum.Add(powers[i])
+	}
+	return sum.ToDec().Quo(total.ToDec())
+}
@@ -0,0 +1,52 @@
+package decentralization_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/decentralization"
+)
+
+func toInts(values ...int64) []sdk.Int {
+	ints := make([]sdk.Int, len(values))
+	for i, value := range values {
+		ints[i] = sdk.NewInt(value)
+	}
+	return ints
+}
+
+func TestComputeMetrics(t *testing.T) {
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Even distribution
+	metrics := decentralization.ComputeMetrics(toInts(10, 10, 10, 10, 10, 10), 10, timestamp)
+	require.Equal(t, int64(3), metrics.NakamotoCoefficient33)
+	require.Equal(t, int64(5), metrics.NakamotoCoefficient67)
+	require.True(t, metrics.GiniCoefficient.IsZero())
+	require.Equal(t, sdk.OneDec(), metrics.Top10Share)
+	require.Equal(t, int64(6), metrics.ActiveSetSize)
+	require.Equal(t, sdk.NewInt(60), metrics.TotalVotingPower)
+
+	// Concentrated distribution, with validators out of the active set being ignored
+	metrics = decentralization.ComputeMetrics(toInts(0, 1, 1, 1, 97), 11, timestamp)
+	require.Equal(t, int64(1), metrics.NakamotoCoefficient33)
+	require.Equal(t, int64(1), metrics.NakamotoCoefficient67)
+	require.Equal(t, sdk.MustNewDecFromStr("0.72"), metrics.GiniCoefficient)
+	require.Equal(t, int64(4), metrics.ActiveSetSize)
+
+	// Top shares with more than 10 validators
+	metrics = decentralization.ComputeMetrics(toInts(5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5), 12, timestamp)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), metrics.Top10Share)
+	require.Equal(t, sdk.OneDec(), metrics.Top20Share)
+	require.Equal(t, int64(7), metrics.NakamotoCoefficient33)
+	require.Equal(t, int64(14), metrics.NakamotoCoefficient67)
+
+	// Empty set
+	metrics = decentralization.ComputeMetrics(nil, 13, timestamp)
+	require.Equal(t, int64(0), metrics.NakamotoCoefficient33)
+	require.True(t, metrics.GiniCoefficient.IsZero())
+	require.True(t, metrics.Top10Share.IsZero())
+}
@@ -0,0 +1,28 @@
+package decentralization
+
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that keeps track of the decentralization metrics of the validators set
+type Module struct {
+	db database.Database
+}
+
+// NewModule builds a new Module instance
+func NewModule(db database.Database) *Module {
+	return &Module{
+		db: db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "decentralization"
+}
@@ -0,0 +1,68 @@
+package delegations
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DownloadState implements modules.FastSyncModule
+func (m *Module) DownloadState(height int64) error {
+	log.Debug().Str("module", "delegations").Int64("height", height).Msg("refreshing all delegations")
+
+	addresses, err := m.getKnownDelegators()
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = m.RefreshDelegations(address, height)
+		if err != nil {
+			log.Error().Str("module", "delegations").Err(err).Int64("height", height).
+				Str("address", address).Msg("error while refreshing delegations")
+		}
+	}
+
+	return nil
+}
+
+// getKnownDelegators returns the addresses of the delegators already stored in database,
+// along with the validators and all the accounts currently delegating to them
+func (m *Module) getKnownDelegators() ([]string, error) {
+	stored, err := m.db.GetDelegatorsAddresses()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting stored delegators: %s", err)
+	}
+
+	validators, err := m.db.GetValidatorsVotingPower()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators: %s", err)
+	}
+
+	addresses := map[string]bool{}
+	for _, address := range stored {
+		addresses[address] = true
+	}
+
+	for _, validator := range validators {
+		addresses[validator.SelfDelegateAddress] = true
+
+		delegations, err := m.source.ValidatorDelegations(validator.SelfDelegateAddress)
+		if err != nil {
+			log.Error().Str("module", "delegations").Err(err).Str("validator", validator.SelfDelegateAddress).
+				Msg("error while getting validator delegations")
+			continue
+		}
+
+		for _, delegation := range delegations {
+			addresses[delegation.Delegation.DelegatorAddress] = true
+		}
+	}
+
+	var result []string
+	for address := range addresses {
+		result = append(result, address)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,21 @@
+package delegations
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/messages"
+	"github.com/forbole/njuno/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *types.TxResponse) error {
+	for _, address := range messages.GetTxAddresses(m.cdc, m.parser, tx) {
+		err := m.RefreshDelegations(address, tx.Height)
+		if err != nil {
+			log.Error().Str("module", "delegations").Err(err).Int64("height", tx.Height).
+				Str("address", address).Msg("error while refreshing delegations")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package delegations
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/messages"
+	"github.com/forbole/njuno/node"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+	_ modules.FastSyncModule    = &Module{}
+)
+
+// Module represents the module that indexes the delegations, unbonding delegations and redelegations
+// of the accounts involved in staking messages
+type Module struct {
+	cdc    codec.Marshaler
+	db     database.Database
+	source node.Node
+	parser messages.MessageAddressesParser
+}
+
+// NewModule builds a new Module instance
+func NewModule(cdc codec.Marshaler, db database.Database, source node.Node) *Module {
+	return &Module{
+		cdc:    cdc,
+		db:     db,
+		source: source,
+		parser: messages.StakingMessagesParser,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "delegations"
+}
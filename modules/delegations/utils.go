@@ -0,0 +1,70 @@
+package delegations
+
+import (
+	"fmt"
+
+	"github.com/forbole/njuno/types"
+)
+
+// RefreshDelegations queries the delegations, unbonding delegations and redelegations
+// of the given address at the given height and stores them associated with that height
+func (m *Module) RefreshDelegations(address string, height int64) error {
+	delegationsRes, err := m.source.Delegations(address, height)
+	if err != nil {
+		return fmt.Errorf("error while getting delegations: %s", err)
+	}
+
+	unbondingsRes, err := m.source.UnbondingDelegations(address, height)
+	if err != nil {
+		return fmt.Errorf("error while getting unbonding delegations: %s", err)
+	}
+
+	redelegationsRes, err := m.source.Redelegations(address, height)
+	if err != nil {
+		return fmt.Errorf("error while getting redelegations: %s", err)
+	}
+
+	delegations := make([]types.Delegation, len(delegationsRes))
+	for i, res := range delegationsRes {
+		delegations[i] = types.NewDelegation(
+			res.Delegation.DelegatorAddress,
+			res.Delegation.ValidatorAddress,
+			res.Balance,
+			res.Delegation.Shares,
+			height,
+		)
+	}
+
+	var unbondings []types.UnbondingDelegation
+	for _, res := range unbondingsRes {
+		for _, entry := range res.Entries {
+			unbondings = append(unbondings, types.NewUnbondingDelegation(
+				res.DelegatorAddress,
+				res.ValidatorAddress,
+				entry.InitialBalance,
+				entry.Balance,
+				entry.CreationHeight,
+				entry.CompletionTime,
+				height,
+			))
+		}
+	}
+
+	var redelegations []types.Redelegation
+	for _, res := range redelegationsRes {
+		for _, entry := range res.Entries {
+			redelegations = append(redelegations, types.NewRedelegation(
+				res.Redelegation.DelegatorAddress,
+				res.Redelegation.ValidatorSrcAddress,
+				res.Redelegation.ValidatorDstAddress,
+				entry.RedelegationEntry.InitialBalance,
+				entry.Balance,
+				entry.RedelegationEntry.CreationHeight,
+				entry.RedelegationEntry.CompletionTime,
+				height,
+			))
+		}
+	}
+
+	return m.db.SaveDelegatorDelegations(address, delegations, unbondings, redelegations, height)
+}
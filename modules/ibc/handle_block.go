@@ -0,0 +1,22 @@
+package ibc
+
+import (
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, _ *tmctypes.ResultValidators,
+) error {
+	if results == nil {
+		return nil
+	}
+
+	packets, transfers := parseBlockPackets(block, results)
+	err := m.db.SaveIBCPackets(packets)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveIBCTokenTransfers(transfers)
+}
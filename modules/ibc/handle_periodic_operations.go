@@ -2,6 +2,8 @@ package ibc
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/forbole/njuno/modules/utils"
 	"github.com/forbole/njuno/types"
@@ -20,6 +22,13 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return err
 	}
 
+	// Setup a cron job to snapshot the IBC state every 10 minutes
+	if _, err := scheduler.Every(10).Minutes().Do(func() {
+		utils.WatchMethod(m.updateIBCState)
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,3 +51,109 @@ func (m *Module) updateIBCTransferParams() error {
 	return m.db.SaveIBCTransferParams(types.NewIBCTransferParams(params, height))
 
 }
+
+// updateIBCState takes a snapshot of the current IBC clients, connections,
+// channels and denom traces and stores it inside the database.
+// Each entity type is updated independently, so that a failure does not prevent the others from being stored
+func (m *Module) updateIBCState() error {
+	height, err := m.db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Str("module", "ibc").Int64("height", height).
+		Msg("updating ibc state")
+
+	var errs []string
+	for _, update := range []struct {
+		name string
+		fn   func(height int64) error
+	}{
+		{"clients", m.updateIBCClients},
+		{"connections", m.updateIBCConnections},
+		{"channels", m.updateIBCChannels},
+		{"denom traces", m.updateIBCDenomTraces},
+	} {
+		if err := update.fn(height); err != nil {
+			log.Error().Str("module", "ibc").Int64("height", height).Err(err).
+				Msgf("error while updating ibc %s", update.name)
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error while updating ibc state: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// updateIBCClients stores the current IBC light clients, flagging the ones that are expired or frozen
+func (m *Module) updateIBCClients(height int64) error {
+	states, err := m.source.IBCClientStates()
+	if err != nil {
+		return fmt.Errorf("error while getting ibc client states: %s", err)
+	}
+
+	now := time.Now().UTC()
+	clients := make([]types.IBCClient, len(states))
+	for i, state := range states {
+		state := state
+		clients[i] = convertClient(state, func() (time.Time, error) {
+			consensusState, err := m.source.IBCConsensusState(state.ClientID, state.ClientState.LatestHeight)
+			return consensusState.ConsensusState.Timestamp, err
+		}, height, now)
+	}
+
+	err = m.db.SaveIBCClients(clients)
+	if err != nil {
+		return fmt.Errorf("error while saving ibc clients: %s", err)
+	}
+
+	return nil
+}
+
+// updateIBCConnections stores the current IBC connections
+func (m *Module) updateIBCConnections(height int64) error {
+	connections, err := m.source.IBCConnections()
+	if err != nil {
+		return fmt.Errorf("error while getting ibc connections: %s", err)
+	}
+
+	err = m.db.SaveIBCConnections(convertConnections(connections, height))
+	if err != nil {
+		return fmt.Errorf("error while saving ibc connections: %s", err)
+	}
+
+	return nil
+}
+
+// updateIBCChannels stores the current IBC channels
+func (m *Module) updateIBCChannels(height int64) error {
+	channels, err := m.source.IBCChannels()
+	if err != nil {
+		return fmt.Errorf("error while getting ibc channels: %s", err)
+	}
+
+	err = m.db.SaveIBCChannels(convertChannels(channels, height))
+	if err != nil {
+		return fmt.Errorf("error while saving ibc channels: %s", err)
+	}
+
+	return nil
+}
+
+// updateIBCDenomTraces stores the current IBC denom traces
+func (m *Module) updateIBCDenomTraces(height int64) error {
+	traces, err := m.source.IBCDenomTraces()
+	if err != nil {
+		return fmt.Errorf("error while getting ibc denom traces: %s", err)
+	}
+
+	err = m.db.SaveIBCDenomTraces(convertDenomTraces(traces, height))
+	if err != nil {
+		return fmt.Errorf("error while saving ibc denom traces: %s", err)
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 var (
 	_ modules.Module                   = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
+	_ modules.BlockModule              = &Module{}
 )
 
 // Module represents the ibc module
@@ -0,0 +1,330 @@
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/rs/zerolog/log"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	TxMsgTypeRecvPacket      = "cosmos-sdk/MsgRecvPacket"
+	TxMsgTypeAcknowledgement = "cosmos-sdk/MsgAcknowledgement"
+	TxMsgTypeTimeout         = "cosmos-sdk/MsgTimeout"
+	TxMsgTypeTimeoutOnClose  = "cosmos-sdk/MsgTimeoutOnClose"
+)
+
+// packetStatuses contains the status reached by a packet when each of the channel events is emitted
+var packetStatuses = map[string]string{
+	channeltypes.EventTypeSendPacket:        types.IBCPacketStatusSent,
+	channeltypes.EventTypeRecvPacket:        types.IBCPacketStatusReceived,
+	channeltypes.EventTypeAcknowledgePacket: types.IBCPacketStatusAcknowledged,
+	channeltypes.EventTypeTimeoutPacket:     types.IBCPacketStatusTimeout,
+}
+
+// getRelayerAddress returns the signer of the first packet relay message contained inside the given
+// raw transaction, or an empty string if the transaction does not relay any packet
+func getRelayerAddress(txBz []byte) string {
+	var tx types.TxResponse
+	if json.Unmarshal(txBz, &tx) != nil {
+		return ""
+	}
+
+	for _, msg := range tx.Msg {
+		switch msg.Type {
+		case TxMsgTypeRecvPacket, TxMsgTypeAcknowledgement, TxMsgTypeTimeout, TxMsgTypeTimeoutOnClose:
+			if msg.Value.Signer != "" {
+				return msg.Value.Signer
+			}
+		}
+	}
+
+	return ""
+}
+
+// parsePacketEvents returns the packets lifecycle steps and the fungible token transfers contained
+// inside the given events, which have been emitted by the transaction having the given hash
+func parsePacketEvents(
+	events []abci.Event, txHash, relayer string, height int64, timestamp time.Time,
+) ([]types.IBCPacket, []types.IBCTokenTransfer) {
+	var packets []types.IBCPacket
+	var transfers []types.IBCTokenTransfer
+
+	for _, event := range events {
+		status, ok := packetStatuses[event.Type]
+		if !ok {
+			continue
+		}
+
+		attributes := map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		timeoutTimestamp, _ := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+
+		packet := types.IBCPacket{
+			SourcePort:         attributes[channeltypes.AttributeKeySrcPort],
+			SourceChannel:      attributes[channeltypes.AttributeKeySrcChannel],
+			DestinationPort:    attributes[channeltypes.AttributeKeyDstPort],
+			DestinationChannel: attributes[channeltypes.AttributeKeyDstChannel],
+			Sequence:           sequence,
+			Data:               attributes[channeltypes.AttributeKeyData],
+			TimeoutHeight:      attributes[channeltypes.AttributeKeyTimeoutHeight],
+			TimeoutTimestamp:   timeoutTimestamp,
+			Status:             status,
+			TxHash:             txHash,
+			Height:             height,
+		}
+
+		// Packets are sent by users, while all the other steps are performed by relayers
+		if status != types.IBCPacketStatusSent {
+			packet.RelayerAddress = relayer
+		}
+
+		packets = append(packets, packet)
+
+		// Only sent and received packets represent a transfer of the chain
+		var direction, channel string
+		switch status {
+		case types.IBCPacketStatusSent:
+			direction, channel = types.IBCTransferDirectionOutgoing, packet.SourceChannel
+		case types.IBCPacketStatusReceived:
+			direction, channel = types.IBCTransferDirectionIncoming, packet.DestinationChannel
+		default:
+			continue
+		}
+
+		var data ibctransfertypes.FungibleTokenPacketData
+		if err := ibctransfertypes.ModuleCdc.UnmarshalJSON([]byte(packet.Data), &data); err != nil {
+			// The packet data is not a FungibleTokenPacketData, so it is not a transfer
+			continue
+		}
+
+		transfers = append(transfers, types.IBCTokenTransfer{
+			SourcePort:    packet.SourcePort,
+			SourceChannel: packet.SourceChannel,
+			Sequence:      packet.Sequence,
+			Direction:     direction,
+			Channel:       channel,
+			Denom:         data.Denom,
+			Amount:        sdk.NewIntFromUint64(data.Amount),
+			Sender:        data.Sender,
+			Receiver:      data.Receiver,
+			TxHash:        txHash,
+			Height:        height,
+			Timestamp:     timestamp,
+		})
+	}
+
+	return packets, transfers
+}
+
+// parseBlockPackets returns the packets lifecycle steps and the fungible token transfers emitted by the
+// successful transactions of the given block
+func parseBlockPackets(
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults,
+) ([]types.IBCPacket, []types.IBCTokenTransfer) {
+	var packets []types.IBCPacket
+	var transfers []types.IBCTokenTransfer
+	for index, txResult := range results.TxsResults {
+		// Failed transactions do not change the packets state
+		if txResult.Code != 0 || index >= len(block.Block.Txs) {
+			continue
+		}
+
+		tx := block.Block.Txs[index]
+		txPackets, txTransfers := parsePacketEvents(
+			txResult.Events, fmt.Sprintf("%X", tx.Hash()), getRelayerAddress(tx),
+			block.Block.Height, block.Block.Time,
+		)
+		packets = append(packets, txPackets...)
+		transfers = append(transfers, txTransfers...)
+	}
+	return packets, transfers
+}
+
+// GetTransfersInvolvedAddresses returns the chain accounts whose balance might have been changed by the fungible
+// token packets of the given block without being involved in its messages: the receivers of the received transfers,
+// and the senders of the acknowledged or timed out transfers, which are refunded when the transfer fails
+func GetTransfersInvolvedAddresses(block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults) []string {
+	if results == nil {
+		return nil
+	}
+
+	var addresses []string
+	packets, _ := parseBlockPackets(block, results)
+	for _, packet := range packets {
+		if packet.Status != types.IBCPacketStatusReceived {
+			continue
+		}
+
+		var data ibctransfertypes.FungibleTokenPacketData
+		if err := ibctransfertypes.ModuleCdc.UnmarshalJSON([]byte(packet.Data), &data); err == nil {
+			addresses = append(addresses, data.Receiver)
+		}
+	}
+
+	// The acknowledgement and timeout events do not contain the packet data, so the senders are read from the messages
+	for index, txResult := range results.TxsResults {
+		if txResult.Code != 0 || index >= len(block.Block.Txs) {
+			continue
+		}
+		addresses = append(addresses, getRefundableSenders(block.Block.Txs[index])...)
+	}
+
+	return addresses
+}
+
+// getRefundableSenders returns the senders of the fungible token packets that are acknowledged or timed out
+// by the messages of the given raw transaction
+func getRefundableSenders(txBz []byte) []string {
+	var tx struct {
+		Msg []struct {
+			Type  string `json:"type"`
+			Value struct {
+				Packet struct {
+					Data []byte `json:"data"`
+				} `json:"packet"`
+			} `json:"value"`
+		} `json:"msg"`
+	}
+	if json.Unmarshal(txBz, &tx) != nil {
+		return nil
+	}
+
+	var senders []string
+	for _, msg := range tx.Msg {
+		switch msg.Type {
+		case TxMsgTypeAcknowledgement, TxMsgTypeTimeout, TxMsgTypeTimeoutOnClose:
+			var data ibctransfertypes.FungibleTokenPacketData
+			if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(msg.Value.Packet.Data, &data); err == nil {
+				senders = append(senders, data.Sender)
+			}
+		}
+	}
+	return senders
+}
+
+// getClientStatus returns the status of a light client given whether it is frozen, the timestamp
+// of its latest consensus state and its trusting period
+func getClientStatus(frozen bool, latestTimestamp time.Time, trustingPeriod time.Duration, now time.Time) string {
+	if frozen {
+		return types.IBCClientStatusFrozen
+	}
+
+	if latestTimestamp.IsZero() || !latestTimestamp.Add(trustingPeriod).After(now) {
+		return types.IBCClientStatusExpired
+	}
+
+	return types.IBCClientStatusActive
+}
+
+// convertClient converts the given client state response into an IBCClient instance, using the given function
+// to get the timestamp of its latest consensus state. When either the trusting period or the latest consensus state
+// of the client can not be read (e.g. the 09-localhost client does not have any), its status is set to unknown
+func convertClient(
+	state types.IdentifiedClientStateResponse, getTimestamp func() (time.Time, error), height int64, now time.Time,
+) types.IBCClient {
+	frozen := !state.ClientState.FrozenHeight.IsZero()
+	var frozenHeight string
+	if frozen {
+		frozenHeight = state.ClientState.FrozenHeight.String()
+	}
+
+	client := types.IBCClient{
+		ClientID:     state.ClientID,
+		ChainID:      state.ClientState.ChainID,
+		LatestHeight: state.ClientState.LatestHeight.String(),
+		FrozenHeight: frozenHeight,
+		Status:       types.IBCClientStatusUnknown,
+		Height:       height,
+	}
+
+	trustingPeriod, err := time.ParseDuration(state.ClientState.TrustingPeriod)
+	if err != nil {
+		log.Error().Str("module", "ibc").Str("client_id", state.ClientID).Err(err).
+			Msg("error while parsing the client trusting period")
+		return client
+	}
+	client.TrustingPeriod = trustingPeriod
+
+	if !state.ClientState.LatestHeight.IsZero() {
+		timestamp, err := getTimestamp()
+		if err != nil {
+			log.Error().Str("module", "ibc").Str("client_id", state.ClientID).Err(err).
+				Msg("error while getting the client consensus state")
+			return client
+		}
+		client.LatestTimestamp = timestamp
+	}
+
+	client.Status = getClientStatus(frozen, client.LatestTimestamp, trustingPeriod, now)
+	return client
+}
+
+// convertConnections converts the given connections responses into IBCConnection instances
+func convertConnections(connections []types.IdentifiedConnectionResponse, height int64) []types.IBCConnection {
+	result := make([]types.IBCConnection, len(connections))
+	for i, conn := range connections {
+		result[i] = types.IBCConnection{
+			ConnectionID:             conn.ID,
+			ClientID:                 conn.ClientID,
+			State:                    conn.State,
+			CounterpartyClientID:     conn.Counterparty.ClientID,
+			CounterpartyConnectionID: conn.Counterparty.ConnectionID,
+			DelayPeriod:              conn.DelayPeriod,
+			Height:                   height,
+		}
+	}
+	return result
+}
+
+// convertChannels converts the given channels responses into IBCChannel instances
+func convertChannels(channels []types.IdentifiedChannelResponse, height int64) []types.IBCChannel {
+	result := make([]types.IBCChannel, len(channels))
+	for i, channel := range channels {
+		result[i] = types.IBCChannel{
+			PortID:                channel.PortID,
+			ChannelID:             channel.ChannelID,
+			State:                 channel.State,
+			Ordering:              channel.Ordering,
+			CounterpartyPortID:    channel.Counterparty.PortID,
+			CounterpartyChannelID: channel.Counterparty.ChannelID,
+			ConnectionHops:        channel.ConnectionHops,
+			Version:               channel.Version,
+			Height:                height,
+		}
+	}
+	return result
+}
+
+// convertDenomTraces converts the given denom traces responses into IBCDenomTrace instances,
+// computing the ibc/{hash} denom of each voucher
+func convertDenomTraces(traces []types.DenomTraceResponse, height int64) []types.IBCDenomTrace {
+	result := make([]types.IBCDenomTrace, len(traces))
+	for i, trace := range traces {
+		denomTrace := ibctransfertypes.DenomTrace{Path: trace.Path, BaseDenom: trace.BaseDenom}
+		result[i] = types.IBCDenomTrace{
+			Denom:     denomTrace.IBCDenom(),
+			Path:      trace.Path,
+			BaseDenom: trace.BaseDenom,
+			Height:    height,
+		}
+	}
+	return result
+}
@@ -0,0 +1,181 @@
+package ibc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+func packetEvent(eventType, srcChannel, dstChannel, sequence, data string) abci.Event {
+	return abci.Event{Type: eventType, Attributes: []abci.EventAttribute{
+		{Key: []byte(channeltypes.AttributeKeySrcPort), Value: []byte("transfer")},
+		{Key: []byte(channeltypes.AttributeKeySrcChannel), Value: []byte(srcChannel)},
+		{Key: []byte(channeltypes.AttributeKeyDstPort), Value: []byte("transfer")},
+		{Key: []byte(channeltypes.AttributeKeyDstChannel), Value: []byte(dstChannel)},
+		{Key: []byte(channeltypes.AttributeKeySequence), Value: []byte(sequence)},
+		{Key: []byte(channeltypes.AttributeKeyData), Value: []byte(data)},
+		{Key: []byte(channeltypes.AttributeKeyTimeoutTimestamp), Value: []byte("1667260800000000000")},
+	}}
+}
+
+func TestGetRelayerAddress(t *testing.T) {
+	tx := []byte(`{"msg":[{"type":"cosmos-sdk/MsgUpdateClient","value":{}},{"type":"cosmos-sdk/MsgRecvPacket","value":{"signer":"nomic1relayer"}}]}`)
+	require.Equal(t, "nomic1relayer", getRelayerAddress(tx))
+
+	tx = []byte(`{"msg":[{"type":"cosmos-sdk/MsgDelegate","value":{}}]}`)
+	require.Empty(t, getRelayerAddress(tx))
+
+	require.Empty(t, getRelayerAddress([]byte("invalid")))
+}
+
+func TestParsePacketEvents(t *testing.T) {
+	timestamp := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	data := string(ibctransfertypes.NewFungibleTokenPacketData("unom", 100, "nomic1sender", "osmo1receiver").GetBytes())
+
+	events := []abci.Event{
+		{Type: "message"},
+		packetEvent(channeltypes.EventTypeSendPacket, "channel-0", "channel-5", "7", data),
+		packetEvent(channeltypes.EventTypeRecvPacket, "channel-5", "channel-0", "3", data),
+		packetEvent(channeltypes.EventTypeAcknowledgePacket, "channel-0", "channel-5", "6", ""),
+		packetEvent(channeltypes.EventTypeTimeoutPacket, "channel-0", "channel-5", "invalid", ""),
+	}
+
+	packets, transfers := parsePacketEvents(events, "HASH", "nomic1relayer", 10, timestamp)
+	require.Len(t, packets, 3)
+
+	require.Equal(t, types.IBCPacketStatusSent, packets[0].Status)
+	require.Equal(t, uint64(7), packets[0].Sequence)
+	require.Equal(t, uint64(1667260800000000000), packets[0].TimeoutTimestamp)
+	require.Empty(t, packets[0].RelayerAddress)
+
+	require.Equal(t, types.IBCPacketStatusReceived, packets[1].Status)
+	require.Equal(t, "nomic1relayer", packets[1].RelayerAddress)
+
+	require.Equal(t, types.IBCPacketStatusAcknowledged, packets[2].Status)
+	require.Equal(t, "HASH", packets[2].TxHash)
+
+	require.Equal(t, []types.IBCTokenTransfer{
+		{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Sequence:      7,
+			Direction:     types.IBCTransferDirectionOutgoing,
+			Channel:       "channel-0",
+			Denom:         "unom",
+			Amount:        sdk.NewInt(100),
+			Sender:        "nomic1sender",
+			Receiver:      "osmo1receiver",
+			TxHash:        "HASH",
+			Height:        10,
+			Timestamp:     timestamp,
+		},
+		{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-5",
+			Sequence:      3,
+			Direction:     types.IBCTransferDirectionIncoming,
+			Channel:       "channel-0",
+			Denom:         "unom",
+			Amount:        sdk.NewInt(100),
+			Sender:        "nomic1sender",
+			Receiver:      "osmo1receiver",
+			TxHash:        "HASH",
+			Height:        10,
+			Timestamp:     timestamp,
+		},
+	}, transfers)
+}
+
+func TestGetTransfersInvolvedAddresses(t *testing.T) {
+	received := string(ibctransfertypes.NewFungibleTokenPacketData("uatom", 100, "cosmos1sender", "nomic1receiver").GetBytes())
+	refunded := ibctransfertypes.NewFungibleTokenPacketData("unom", 100, "nomic1sender", "osmo1receiver").GetBytes()
+	failed := ibctransfertypes.NewFungibleTokenPacketData("unom", 100, "nomic1failed", "osmo1receiver").GetBytes()
+
+	block := &tmctypes.ResultBlock{Block: &tmtypes.Block{
+		Header: tmtypes.Header{Height: 10},
+		Data: tmtypes.Data{Txs: tmtypes.Txs{
+			[]byte(`{"msg":[{"type":"cosmos-sdk/MsgRecvPacket","value":{"signer":"nomic1relayer"}}]}`),
+			[]byte(fmt.Sprintf(`{"msg":[{"type":"cosmos-sdk/MsgTimeout","value":{"packet":{"data":"%s"}}}]}`,
+				base64.StdEncoding.EncodeToString(refunded))),
+			[]byte(fmt.Sprintf(`{"msg":[{"type":"cosmos-sdk/MsgAcknowledgement","value":{"packet":{"data":"%s"}}}]}`,
+				base64.StdEncoding.EncodeToString(failed))),
+		}},
+	}}
+	results := &tmctypes.ResultBlockResults{TxsResults: []*abci.ResponseDeliverTx{
+		{Events: []abci.Event{packetEvent(channeltypes.EventTypeRecvPacket, "channel-5", "channel-0", "3", received)}},
+		{Events: []abci.Event{packetEvent(channeltypes.EventTypeTimeoutPacket, "channel-0", "channel-5", "7", "")}},
+		{Code: 1},
+	}}
+
+	require.Equal(t, []string{"nomic1receiver", "nomic1sender"}, GetTransfersInvolvedAddresses(block, results))
+	require.Empty(t, GetTransfersInvolvedAddresses(block, nil))
+}
+
+func TestGetClientStatus(t *testing.T) {
+	now := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	trustingPeriod := 14 * 24 * time.Hour
+
+	require.Equal(t, types.IBCClientStatusActive, getClientStatus(false, now.Add(-time.Hour), trustingPeriod, now))
+	require.Equal(t, types.IBCClientStatusExpired, getClientStatus(false, now.Add(-trustingPeriod), trustingPeriod, now))
+	require.Equal(t, types.IBCClientStatusExpired, getClientStatus(false, time.Time{}, trustingPeriod, now))
+	require.Equal(t, types.IBCClientStatusFrozen, getClientStatus(true, now.Add(-time.Hour), trustingPeriod, now))
+}
+
+func TestConvertClient(t *testing.T) {
+	now := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	latestTimestamp := now.Add(-time.Hour)
+
+	var state types.IdentifiedClientStateResponse
+	state.ClientID = "07-tendermint-0"
+	state.ClientState.ChainID = "cosmoshub-4"
+	state.ClientState.TrustingPeriod = "1209600s"
+	state.ClientState.LatestHeight = types.IBCHeightResponse{RevisionNumber: 4, RevisionHeight: 100}
+
+	client := convertClient(state, func() (time.Time, error) { return latestTimestamp, nil }, 10, now)
+	require.Equal(t, types.IBCClient{
+		ClientID:        "07-tendermint-0",
+		ChainID:         "cosmoshub-4",
+		TrustingPeriod:  14 * 24 * time.Hour,
+		LatestHeight:    "4-100",
+		LatestTimestamp: latestTimestamp,
+		Status:          types.IBCClientStatusActive,
+		Height:          10,
+	}, client)
+
+	// Failing to get the consensus state does not prevent the client from being returned
+	client = convertClient(state, func() (time.Time, error) { return time.Time{}, fmt.Errorf("not found") }, 10, now)
+	require.Equal(t, types.IBCClientStatusUnknown, client.Status)
+	require.Equal(t, 14*24*time.Hour, client.TrustingPeriod)
+
+	// The 09-localhost client does not have any trusting period
+	var localhost types.IdentifiedClientStateResponse
+	localhost.ClientID = "09-localhost"
+	localhost.ClientState.LatestHeight = types.IBCHeightResponse{RevisionHeight: 100}
+
+	client = convertClient(localhost, func() (time.Time, error) {
+		t.Fatal("consensus state should not be queried")
+		return time.Time{}, nil
+	}, 10, now)
+	require.Equal(t, types.IBCClientStatusUnknown, client.Status)
+	require.Equal(t, "09-localhost", client.ClientID)
+	require.Zero(t, client.TrustingPeriod)
+}
+
+func TestConvertDenomTraces(t *testing.T) {
+	traces := convertDenomTraces([]types.DenomTraceResponse{{Path: "transfer/channel-0", BaseDenom: "uatom"}}, 10)
+	require.Len(t, traces, 1)
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", traces[0].Denom)
+	require.Equal(t, "uatom", traces[0].BaseDenom)
+	require.Equal(t, int64(10), traces[0].Height)
+}
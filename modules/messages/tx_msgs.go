@@ -0,0 +1,110 @@
+package messages
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	TxMsgTypeSend            = "cosmos-sdk/MsgSend"
+	TxMsgTypeDelegate        = "cosmos-sdk/MsgDelegate"
+	TxMsgTypeUndelegate      = "cosmos-sdk/MsgUndelegate"
+	TxMsgTypeBeginRedelegate = "cosmos-sdk/MsgBeginRedelegate"
+)
+
+// ConvertTxMsg converts the given transaction message into the corresponding Cosmos message,
+// so that it can be handled by the MessageAddressesParser implementations.
+// Returns false if the message type is not supported.
+func ConvertTxMsg(msg types.TxMsg) (sdk.Msg, bool) {
+	switch msg.Type {
+	case TxMsgTypeSend:
+		// The amount is not converted since the message value only supports a single coin
+		return &banktypes.MsgSend{
+			FromAddress: msg.Value.FromAddress,
+			ToAddress:   msg.Value.ToAddress,
+		}, true
+
+	case TxMsgTypeDelegate:
+		return &stakingtypes.MsgDelegate{
+			DelegatorAddress: msg.Value.DelegatorAddress,
+			ValidatorAddress: msg.Value.ValidatorAddress,
+			Amount:           msg.Value.Amount,
+		}, true
+
+	case TxMsgTypeUndelegate:
+		return &stakingtypes.MsgUndelegate{
+			DelegatorAddress: msg.Value.DelegatorAddress,
+			ValidatorAddress: msg.Value.ValidatorAddress,
+			Amount:           msg.Value.Amount,
+		}, true
+
+	case TxMsgTypeBeginRedelegate:
+		return &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    msg.Value.DelegatorAddress,
+			ValidatorSrcAddress: msg.Value.ValidatorSrcAddress,
+			ValidatorDstAddress: msg.Value.ValidatorDstAddress,
+			Amount:              msg.Value.Amount,
+		}, true
+	}
+
+	return nil, false
+}
+
+// GetTxAddresses returns all the addresses involved in the messages of the given transaction,
+// without duplicates, using the given parser. Messages that are not supported by ConvertTxMsg are skipped
+func GetTxAddresses(cdc codec.Marshaler, parser MessageAddressesParser, tx *types.TxResponse) []string {
+	var addresses []string
+	found := map[string]bool{}
+	for _, txMsg := range tx.Msg {
+		addresses = appendUnique(addresses, found, getMsgAddresses(cdc, parser, txMsg)...)
+	}
+
+	return addresses
+}
+
+// GetTxInvolvedAddresses returns all the addresses involved in the given transaction, without duplicates.
+// Along with the addresses returned by the given parser for the supported messages, these include the account
+// addresses of every message and the transaction signers, the first of which is the fee payer
+func GetTxInvolvedAddresses(cdc codec.Marshaler, parser MessageAddressesParser, tx *types.TxResponse) []string {
+	var addresses []string
+	found := map[string]bool{}
+	addresses = appendUnique(addresses, found, tx.GetSigners()...)
+	for _, txMsg := range tx.Msg {
+		addresses = appendUnique(addresses, found, getMsgAddresses(cdc, parser, txMsg)...)
+		addresses = appendUnique(addresses, found, txMsg.Value.GetAccountAddresses()...)
+	}
+
+	return addresses
+}
+
+// getMsgAddresses returns the addresses returned by the given parser for the given message,
+// or nil if the message is not supported
+func getMsgAddresses(cdc codec.Marshaler, parser MessageAddressesParser, txMsg types.TxMsg) []string {
+	msg, ok := ConvertTxMsg(txMsg)
+	if !ok {
+		return nil
+	}
+
+	addresses, err := parser(cdc, msg)
+	if err != nil {
+		return nil
+	}
+
+	return addresses
+}
+
+// appendUnique appends to the given slice the non empty addresses that are not contained inside found yet
+func appendUnique(addresses []string, found map[string]bool, values ...string) []string {
+	for _, address := range values {
+		if address == "" || found[address] {
+			continue
+		}
+		found[address] = true
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
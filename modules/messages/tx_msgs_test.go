@@ -0,0 +1,92 @@
+package messages_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/messages"
+	"github.com/forbole/njuno/types"
+)
+
+func TestConvertTxMsg(t *testing.T) {
+	amount := sdk.NewInt64Coin("unom", 10)
+
+	msg, ok := messages.ConvertTxMsg(types.TxMsg{
+		Type:  messages.TxMsgTypeBeginRedelegate,
+		Value: types.TxMsgValue{DelegatorAddress: "del", ValidatorSrcAddress: "src", ValidatorDstAddress: "dst", Amount: amount},
+	})
+	require.True(t, ok)
+	require.Equal(t, &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    "del",
+		ValidatorSrcAddress: "src",
+		ValidatorDstAddress: "dst",
+		Amount:              amount,
+	}, msg)
+
+	msg, ok = messages.ConvertTxMsg(types.TxMsg{
+		Type:  messages.TxMsgTypeSend,
+		Value: types.TxMsgValue{FromAddress: "from", ToAddress: "to"},
+	})
+	require.True(t, ok)
+	require.Equal(t, &banktypes.MsgSend{FromAddress: "from", ToAddress: "to"}, msg)
+
+	_, ok = messages.ConvertTxMsg(types.TxMsg{Type: "nomic/MsgWithdraw"})
+	require.False(t, ok)
+}
+
+func TestGetTxAddresses(t *testing.T) {
+	tx := &types.TxResponse{
+		Msg: []types.TxMsg{
+			{
+				Type:  messages.TxMsgTypeDelegate,
+				Value: types.TxMsgValue{DelegatorAddress: "nomic1delegator", ValidatorAddress: "nomicvaloper1validator"},
+			},
+			{
+				Type:  "cosmos-sdk/MsgTransfer",
+				Value: types.TxMsgValue{FromAddress: "nomic1sender", Signer: "nomic1signer"},
+			},
+			{
+				Type:  "nomic/MsgWithdraw",
+				Value: types.TxMsgValue{FromAddress: "nomic1sender", DstAddress: "bc1destination"},
+			},
+			{
+				Type:  messages.TxMsgTypeUndelegate,
+				Value: types.TxMsgValue{DelegatorAddress: "nomic1delegator", ValidatorAddress: "nomicvaloper1validator"},
+			},
+		},
+	}
+
+	// Only the messages supported by the parser are taken into account
+	require.Equal(t,
+		[]string{"nomic1delegator", "nomicvaloper1validator"},
+		messages.GetTxAddresses(nil, messages.StakingMessagesParser, tx),
+	)
+
+	// All the account addresses and signers are taken into account, starting from the signers
+	require.Equal(t,
+		[]string{"nomic1delegator", "nomic1signer", "nomic1sender", "nomicvaloper1validator"},
+		messages.GetTxInvolvedAddresses(nil, messages.StakingMessagesParser, tx),
+	)
+
+	require.Empty(t, messages.GetTxInvolvedAddresses(nil, messages.StakingMessagesParser, &types.TxResponse{}))
+}
+
+func TestGetTxInvolvedAddresses_Receivers(t *testing.T) {
+	// The amount of the send messages is a list of coins, which makes the unmarshaling return an error
+	// while still reading all the other fields, as done when parsing the blocks transactions
+	var tx types.TxResponse
+	_ = json.Unmarshal([]byte(`{"msg":[
+		{"type":"cosmos-sdk/MsgSend","value":{"from_address":"nomic1sender","to_address":"nomic1receiver","amount":[{"denom":"unom","amount":"10"}]}},
+		{"type":"cosmos-sdk/MsgTransfer","value":{"sender":"nomic1ibcsender","receiver":"osmo1receiver"}}
+	]}`), &tx)
+
+	require.Equal(t,
+		[]string{"nomic1sender", "nomic1ibcsender", "nomic1receiver"},
+		messages.GetTxInvolvedAddresses(nil, messages.CosmosMessageAddressesParser, &tx),
+	)
+}
@@ -0,0 +1,61 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// ComputeAPR returns the annual percentage rate earned by delegators, computed as the yearly minted
+// tokens (inflation * total supply) distributed over the bonded tokens, minus the validators commission
+func ComputeAPR(inflation sdk.Dec, totalSupply, bondedTokens sdk.Int, averageCommission sdk.Dec) sdk.Dec {
+	if !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return inflation.MulInt(totalSupply).QuoInt(bondedTokens).Mul(sdk.OneDec().Sub(averageCommission))
+}
+
+// ComputeAverageCommission returns the average commission of the given validators, weighted by their
+// voting power. Validators without a voting power are ignored, unless none of them has one, in which
+// case the simple average is returned instead
+func ComputeAverageCommission(
+	commissions []types.ValidatorCommission, votingPowers []types.ValidatorVotingPower,
+) (sdk.Dec, error) {
+	powers := make(map[string]sdk.Int, len(votingPowers))
+	for _, votingPower := range votingPowers {
+		power, ok := sdk.NewIntFromString(votingPower.VotingPower)
+		if !ok {
+			return sdk.Dec{}, fmt.Errorf("invalid voting power of validator %s: %s",
+				votingPower.SelfDelegateAddress, votingPower.VotingPower)
+		}
+		powers[votingPower.SelfDelegateAddress] = power
+	}
+
+	sum, weightedSum, totalPower := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt()
+	for _, commission := range commissions {
+		rate, err := sdk.NewDecFromStr(commission.Commission)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("invalid commission of validator %s: %s",
+				commission.SelfDelegateAddress, commission.Commission)
+		}
+		sum = sum.Add(rate)
+
+		if power, ok := powers[commission.SelfDelegateAddress]; ok && power.IsPositive() {
+			weightedSum = weightedSum.Add(rate.MulInt(power))
+			totalPower = totalPower.Add(power)
+		}
+	}
+
+	if totalPower.IsPositive() {
+		return weightedSum.QuoInt(totalPower), nil
+	}
+
+	if len(commissions) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	return sum.QuoInt64(int64(len(commissions))), nil
+}
@@ -0,0 +1,48 @@
+package mint_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/mint"
+	"github.com/forbole/njuno/types"
+)
+
+func TestComputeAPR(t *testing.T) {
+	// 10% inflation with half of the supply bonded and 10% commission
+	apr := mint.ComputeAPR(sdk.MustNewDecFromStr("0.1"), sdk.NewInt(1000), sdk.NewInt(500), sdk.MustNewDecFromStr("0.1"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.18"), apr)
+
+	// No bonded tokens
+	apr = mint.ComputeAPR(sdk.MustNewDecFromStr("0.1"), sdk.NewInt(1000), sdk.ZeroInt(), sdk.ZeroDec())
+	require.True(t, apr.IsZero())
+}
+
+func TestComputeAverageCommission(t *testing.T) {
+	commissions := []types.ValidatorCommission{
+		types.NewValidatorCommission("val1", "address1", "0.1", "1", 10),
+		types.NewValidatorCommission("val2", "address2", "0.2", "1", 10),
+		types.NewValidatorCommission("val3", "address3", "0.5", "1", 10),
+	}
+
+	// Weighted by voting power, ignoring validators without power
+	votingPowers := []types.ValidatorVotingPower{
+		types.NewValidatorVotingPower("val1", "address1", "300", 10),
+		types.NewValidatorVotingPower("val2", "address2", "100", 10),
+	}
+	average, err := mint.ComputeAverageCommission(commissions, votingPowers)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.125"), average)
+
+	// Simple average without any voting power
+	average, err = mint.ComputeAverageCommission(commissions, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.266666666666666666"), average)
+
+	// Invalid commission
+	commissions[0].Commission = "invalid"
+	_, err = mint.ComputeAverageCommission(commissions, votingPowers)
+	require.Error(t, err)
+}
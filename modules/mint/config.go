@@ -0,0 +1,32 @@
+package mint
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultInflationInterval represents the default time between two inflation samples
+const DefaultInflationInterval = time.Hour
+
+// Config contains the configuration of the mint module
+type Config struct {
+	// InflationInterval represents the time between two inflation samples
+	InflationInterval time.Duration `yaml:"inflation_interval,omitempty"`
+}
+
+// DefaultConfig returns the default mint configuration
+func DefaultConfig() *Config {
+	return &Config{
+		InflationInterval: DefaultInflationInterval,
+	}
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"mint"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -1,17 +1,22 @@
 package mint
 
 import (
-	"github.com/forbole/njuno/modules/utils"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-co-op/gocron"
 	"github.com/rs/zerolog/log"
+
+	dbtypes "github.com/forbole/njuno/database/types"
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
 )
 
 // RegisterPeriodicOperations implements modules.PeriodicOperationsModule
 func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 	log.Debug().Str("module", "mint").Msg("setting up periodic tasks")
 
-	// Setup a cron job to run every midnight
-	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+	if _, err := scheduler.Every(m.cfg.InflationInterval).Do(func() {
 		utils.WatchMethod(m.updateInflation)
 	}); err != nil {
 		return err
@@ -20,24 +25,75 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 	return nil
 }
 
-// updateInflation fetches from the REST APIs the latest value for the
-// inflation, and saves it inside the database.
+// updateInflation fetches from the REST APIs the value of the inflation at the latest stored block,
+// saves it inside the database and then updates the staking APR accordingly.
 func (m *Module) updateInflation() error {
 	log.Debug().
 		Str("module", "mint").
 		Str("operation", "inflation").
 		Msg("getting inflation data")
 
-	height, err := m.db.GetLastBlockHeight()
+	block, err := m.db.GetLastBlock()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block: %s", err)
+	}
+
+	// Get the inflation at the same height it is stored with
+	inflation, err := m.source.Inflation(block.Height)
+	if err != nil {
+		return err
+	}
+
+	err = m.db.SaveInflation(inflation, block.Height, block.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	return m.updateStakingAPR(inflation, block)
+}
+
+// updateStakingAPR computes the staking APR using the given inflation, the total supply, the latest staking
+// pool and the validators commission, and stores it associated with the given block
+func (m *Module) updateStakingAPR(inflationValue string, block *dbtypes.BlockRow) error {
+	inflation, err := sdk.NewDecFromStr(inflationValue)
+	if err != nil {
+		return fmt.Errorf("invalid inflation value: %s", inflationValue)
+	}
+
+	totalSupply, err := m.supply.GetTotalSupply()
+	if err != nil {
+		return fmt.Errorf("error while getting total supply: %s", err)
+	}
+
+	pool, err := m.db.GetStakingPool()
 	if err != nil {
 		return err
 	}
 
-	// Get the inflation
-	inflation, err := m.source.Inflation()
+	if pool == nil {
+		log.Debug().Str("module", "mint").Msg("no staking pool found, skipping staking apr update")
+		return nil
+	}
+
+	commissions, err := m.db.GetValidatorsCommission()
 	if err != nil {
 		return err
 	}
 
-	return m.db.SaveInflation(inflation, height)
+	votingPowers, err := m.db.GetValidatorsVotingPower()
+	if err != nil {
+		return err
+	}
+
+	averageCommission, err := ComputeAverageCommission(commissions, votingPowers)
+	if err != nil {
+		return err
+	}
+
+	supplyAmount := totalSupply.AmountOf(m.supply.Denom())
+	apr := ComputeAPR(inflation, supplyAmount, pool.BondedTokens, averageCommission)
+
+	return m.db.SaveStakingAPR(types.NewStakingAPR(
+		inflation, supplyAmount, pool.BondedTokens, averageCommission, apr, block.Height, block.Timestamp,
+	))
 }
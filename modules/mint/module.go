@@ -6,7 +6,9 @@ import (
 	"github.com/forbole/njuno/database"
 	"github.com/forbole/njuno/logging"
 	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/bank/supply"
 	source "github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
 )
 
 var (
@@ -16,18 +18,42 @@ var (
 
 // Module represents the mint module
 type Module struct {
+	cfg    *Config
 	cdc    codec.Marshaler
 	db     database.Database
 	logger logging.Logger
 	source source.Node
+	supply *supply.Provider
 }
 
-func NewModule(cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node) *Module {
+func NewModule(
+	cfg config.Config, cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node,
+	supplyProvider *supply.Provider,
+) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	mintCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if mintCfg == nil {
+		mintCfg = DefaultConfig()
+	}
+	if mintCfg.InflationInterval <= 0 {
+		mintCfg.InflationInterval = DefaultInflationInterval
+	}
+
 	return &Module{
+		cfg:    mintCfg,
 		cdc:    cdc,
 		db:     db,
 		logger: logger,
 		source: source,
+		supply: supplyProvider,
 	}
 }
 
@@ -6,6 +6,8 @@ import (
 	"github.com/go-co-op/gocron"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/types"
 )
 
 // Module represents a generic module without any particular handling of data
@@ -52,6 +54,17 @@ type PeriodicOperationsModule interface {
 	RegisterPeriodicOperations(scheduler *gocron.Scheduler) error
 }
 
+// ReprocessModule represents a module that can ask for already stored heights to be exported again,
+// for example after detecting that their data is inconsistent with the chain.
+// Reprocessing a height stores again only its block, commit and transactions: the modules handlers
+// are not called, so the data derived by the other modules is not affected.
+type ReprocessModule interface {
+	// SetReprocessQueue allows to provide the queue that can be used to export again heights that are
+	// already stored, overriding their block, commit and transactions data.
+	// NOTE. This method will only be run ONCE before starting the parsing of the blocks.
+	SetReprocessQueue(queue types.HeightQueue)
+}
+
 type FastSyncModule interface {
 	// DownloadState allows to download the module state at the given height.
 	// This will be called only when the fast sync is used, and only once for the initial height.
@@ -76,3 +89,10 @@ type BlockModule interface {
 	// will still be called.
 	HandleBlock(block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, vals *tmctypes.ResultValidators) error
 }
+
+type TransactionModule interface {
+	// HandleTx handles a single transaction.
+	// NOTE. The returned error will be logged using the TxError method. All other modules' handlers
+	// will still be called.
+	HandleTx(tx *types.TxResponse) error
+}
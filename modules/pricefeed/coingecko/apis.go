@@ -6,22 +6,38 @@ import (
 	"io/ioutil"
 	"math"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/forbole/njuno/types"
 )
 
+const (
+	PublicAPIURL = "https://api.coingecko.com/api/v3"
+	ProAPIURL    = "https://pro-api.coingecko.com/api/v3"
+
+	// ProAPIKeyHeader represents the header used to authenticate the requests to the pro APIs
+	ProAPIKeyHeader = "x-cg-pro-api-key"
+
+	// DefaultChunkSize represents the maximum number of ids that are queried with a single request
+	DefaultChunkSize = 250
+
+	// DefaultMaxRetries represents the number of times a rate-limited request is retried
+	DefaultMaxRetries = 3
+)
+
+var defaultClient = NewClient("", "", 0, 0)
+
 // GetCoinsList allows to fetch from the remote APIs the list of all the supported tokens
 func GetCoinsList() (coins Tokens, err error) {
-	err = queryCoinGecko("/coins/list", &coins)
-	return coins, err
+	return defaultClient.GetCoinsList()
 }
 
 // GetTokensPrices queries the remote APIs to get the token prices of all the tokens having the given ids
 func GetTokensPrices(ids []string) ([]types.TokenPrice, error) {
-	var prices []MarketTicker
-	query := fmt.Sprintf("/coins/markets?vs_currency=usd&ids=%s", strings.Join(ids, ","))
-	err := queryCoinGecko(query, &prices)
+	prices, err := defaultClient.GetMarketTickers(ids)
 	if err != nil {
 		return nil, err
 	}
@@ -32,34 +48,166 @@ func GetTokensPrices(ids []string) ([]types.TokenPrice, error) {
 func ConvertCoingeckoPrices(prices []MarketTicker) []types.TokenPrice {
 	tokenPrices := make([]types.TokenPrice, len(prices))
 	for i, price := range prices {
-		tokenPrices[i] = types.NewTokenPrice(
-			price.Symbol,
-			price.CurrentPrice,
-			int64(math.Trunc(price.MarketCap)),
-			price.LastUpdated,
-		)
+		tokenPrices[i] = ConvertCoingeckoPrice(price)
 	}
 	return tokenPrices
 }
 
-// queryCoinGecko queries the CoinGecko APIs for the given endpoint
-func queryCoinGecko(endpoint string, ptr interface{}) error {
-	resp, err := http.Get("https://api.coingecko.com/api/v3" + endpoint)
-	if err != nil {
-		return err
+// ConvertCoingeckoPrice converts the given MarketTicker into a TokenPrice instance
+func ConvertCoingeckoPrice(price MarketTicker) types.TokenPrice {
+	return types.NewTokenPrice(
+		price.Symbol,
+		price.CurrentPrice,
+		int64(math.Trunc(price.MarketCap)),
+		price.LastUpdated,
+	)
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// Client allows to query the CoinGecko APIs, either the public or the pro ones
+type Client struct {
+	baseURL    string
+	apiKey     string
+	chunkSize  int
+	maxRetries int
+	client     *http.Client
+}
+
+// NewClient returns a new Client instance. When no base URL is given, the pro APIs are used if
+// an API key is provided and the public ones otherwise.
+func NewClient(baseURL, apiKey string, chunkSize int, timeout time.Duration) *Client {
+	if baseURL == "" {
+		baseURL = PublicAPIURL
+		if apiKey != "" {
+			baseURL = ProAPIURL
+		}
 	}
 
-	defer resp.Body.Close()
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
 
-	bz, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error while reading response body: %s", err)
+	if timeout == 0 {
+		timeout = 30 * time.Second
 	}
 
-	err = json.Unmarshal(bz, &ptr)
-	if err != nil {
-		return fmt.Errorf("error while unmarshaling response body: %s", err)
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		chunkSize:  chunkSize,
+		maxRetries: DefaultMaxRetries,
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+// GetCoinsList returns the list of all the tokens supported by CoinGecko
+func (c *Client) GetCoinsList() (coins Tokens, err error) {
+	err = c.query("/coins/list", &coins)
+	return coins, err
+}
+
+// GetMarketTickers returns the market data of all the tokens having the given ids.
+// The ids are split into chunks so that each request stays within the APIs limits.
+func (c *Client) GetMarketTickers(ids []string) ([]MarketTicker, error) {
+	var tickers []MarketTicker
+	for start := 0; start < len(ids); start += c.chunkSize {
+		end := start + c.chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		var chunk []MarketTicker
+		query := fmt.Sprintf("/coins/markets?vs_currency=usd&per_page=%d&ids=%s",
+			c.chunkSize, url.QueryEscape(strings.Join(ids[start:end], ",")))
+		err := c.query(query, &chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		tickers = append(tickers, chunk...)
+	}
+
+	return tickers, nil
+}
+
+// GetMarketChartRange returns the historical market data of the token having the given id between from and to.
+// Data is returned with a 5 minutes granularity for ranges up to 1 day, hourly up to 90 days and daily above.
+func (c *Client) GetMarketChartRange(id string, from, to time.Time) (chart MarketChart, err error) {
+	query := fmt.Sprintf("/coins/%s/market_chart/range?vs_currency=usd&from=%d&to=%d",
+		url.PathEscape(id), from.Unix(), to.Unix())
+	err = c.query(query, &chart)
+	return chart, err
+}
+
+// ConvertMarketChart converts the given MarketChart into a list of TokenPrice having the given unit name
+func ConvertMarketChart(unitName string, chart MarketChart) []types.TokenPrice {
+	marketCaps := make(map[int64]float64, len(chart.MarketCaps))
+	for _, point := range chart.MarketCaps {
+		marketCaps[int64(point[0])] = point[1]
+	}
+
+	prices := make([]types.TokenPrice, len(chart.Prices))
+	for i, point := range chart.Prices {
+		timestamp := int64(point[0])
+		prices[i] = types.NewTokenPrice(
+			unitName,
+			point[1],
+			int64(math.Trunc(marketCaps[timestamp])),
+			time.Unix(0, timestamp*int64(time.Millisecond)).UTC(),
+		)
 	}
+	return prices
+}
 
-	return nil
+// query queries the CoinGecko APIs for the given endpoint, retrying the requests that are rate-limited
+func (c *Client) query(endpoint string, ptr interface{}) error {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, c.baseURL+endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("error while building request: %s", err)
+		}
+
+		req.Header.Set("Accept", "application/json")
+		if c.apiKey != "" {
+			req.Header.Set(ProAPIKeyHeader, c.apiKey)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		bz, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error while reading response body: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < c.maxRetries {
+			time.Sleep(getRetryDelay(resp.Header.Get("Retry-After"), attempt))
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("coingecko returned status %d: %s", resp.StatusCode, bz)
+		}
+
+		err = json.Unmarshal(bz, &ptr)
+		if err != nil {
+			return fmt.Errorf("error while unmarshaling response body: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// getRetryDelay returns the time to wait before retrying a rate-limited request, using the
+// given Retry-After header value if valid or an increasing delay otherwise
+func getRetryDelay(retryAfter string, attempt int) time.Duration {
+	seconds, err := strconv.Atoi(retryAfter)
+	if err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return time.Duration(attempt+1) * time.Second
 }
@@ -14,6 +14,7 @@ type Tokens []Token
 
 // MarketTicker contains the current market data for a single token
 type MarketTicker struct {
+	ID           string    `json:"id"`
 	Symbol       string    `json:"symbol"`
 	CurrentPrice float64   `json:"current_price"`
 	MarketCap    float64   `json:"market_cap"`
@@ -22,3 +23,10 @@ type MarketTicker struct {
 
 // MarketTickers is an array of MarketTicker
 type MarketTickers []MarketTicker
+
+// MarketChart contains the historical market data of a single token.
+// Each point is made of a unix timestamp in milliseconds and a value.
+type MarketChart struct {
+	Prices     [][2]float64 `json:"prices"`
+	MarketCaps [][2]float64 `json:"market_caps"`
+}
@@ -0,0 +1,30 @@
+package pricefeed
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/njuno/modules/pricefeed/providers"
+)
+
+// Config contains the configuration of the price providers used by the pricefeed module
+type Config struct {
+	// Providers contains the price providers, in the order in which they are queried.
+	// Each provider is asked only for the prices that the previous ones could not return
+	Providers []*providers.Config `yaml:"providers"`
+}
+
+// DefaultConfig returns the default pricefeed configuration, which reads the prices from the CoinGecko public APIs
+func DefaultConfig() *Config {
+	return &Config{
+		Providers: []*providers.Config{providers.DefaultConfig()},
+	}
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"pricefeed"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -2,8 +2,9 @@ package pricefeed
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
-	"github.com/forbole/njuno/modules/pricefeed/coingecko"
 	"github.com/forbole/njuno/modules/utils"
 	"github.com/forbole/njuno/types"
 	"github.com/go-co-op/gocron"
@@ -21,29 +22,72 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up pricefeed period operations: %s", err)
 	}
 
+	// Update the hourly price candles every 5 mins
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(func() error { return m.updateCandles(types.StatsGranularityHour) })
+	}); err != nil {
+		return fmt.Errorf("error while setting up pricefeed period operations: %s", err)
+	}
+
+	// Update the daily price candles every 30 mins
+	if _, err := scheduler.Every(30).Minutes().Do(func() {
+		utils.WatchMethod(func() error { return m.updateCandles(types.StatsGranularityDay) })
+	}); err != nil {
+		return fmt.Errorf("error while setting up pricefeed period operations: %s", err)
+	}
+
 	return nil
 }
 
-// getTokenPrices allows to get the most up-to-date token prices
+// getTokenPrices allows to get the most up-to-date token prices.
+// The unit name of each price is set to the denom of the token unit having its price id, so that the
+// live prices are stored using the same unit names as the backfilled ones
 func (m *Module) getTokenPrices() ([]types.TokenPrice, error) {
-	// Get the list of tokens price id
-	ids, err := m.db.GetTokensPriceID()
+	// Get the denom of the token units indexed by price id
+	units, err := m.db.GetTokenUnitsByPriceID()
 	if err != nil {
 		return nil, fmt.Errorf("error while getting tokens price id: %s", err)
 	}
 
-	if len(ids) == 0 {
+	if len(units) == 0 {
 		log.Debug().Str("module", "pricefeed").Msg("no traded tokens price id found")
 		return nil, nil
 	}
 
 	// Get the tokens prices
-	prices, err := coingecko.GetTokensPrices(ids)
+	pricesByID, err := m.provider.GetTokensPrices(getPriceIDs(units))
 	if err != nil {
 		return nil, fmt.Errorf("error while getting tokens prices: %s", err)
 	}
 
-	return prices, nil
+	return getUnitsPrices(units, pricesByID), nil
+}
+
+// getPriceIDs returns the sorted price ids of the given token units, indexed by price id
+func getPriceIDs(units map[string]string) []string {
+	ids := make([]string, 0, len(units))
+	for id := range units {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// getUnitsPrices returns the prices of the given token units, indexed by price id, using their denom
+// as the unit name. Units whose price has not been found are not included inside the result
+func getUnitsPrices(units map[string]string, pricesByID map[string]types.TokenPrice) []types.TokenPrice {
+	var prices []types.TokenPrice
+	for _, id := range getPriceIDs(units) {
+		price, found := pricesByID[id]
+		if !found {
+			log.Debug().Str("module", "pricefeed").Str("price_id", id).Msg("no price found for token")
+			continue
+		}
+
+		price.UnitName = units[id]
+		prices = append(prices, price)
+	}
+	return prices
 }
 
 // updatePrice fetch total amount of coins in the system from RPC and store it into database
@@ -67,3 +111,22 @@ func (m *Module) updatePrice() error {
 	return nil
 
 }
+
+// updateCandles incrementally updates the token price candles having the given granularity,
+// starting from the latest stored period up to the current one
+func (m *Module) updateCandles(granularity types.StatsGranularity) error {
+	log.Debug().Str("module", "pricefeed").Str("granularity", string(granularity)).
+		Msg("updating token price candles")
+
+	from, err := m.db.GetLastTokenPriceCandlePeriod(granularity)
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no prices stored yet
+	if from.IsZero() {
+		return nil
+	}
+
+	return m.UpdateCandlesInRange(granularity, from, time.Now())
+}
@@ -0,0 +1,28 @@
+package pricefeed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types"
+)
+
+func TestGetUnitsPrices(t *testing.T) {
+	timestamp := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	units := map[string]string{
+		"nomic":   "unom",
+		"bitcoin": "usat",
+		"cosmos":  "uatom",
+	}
+	pricesByID := map[string]types.TokenPrice{
+		"nomic":   types.NewTokenPrice("nom", 1.5, 100, timestamp),
+		"bitcoin": types.NewTokenPrice("btc", 20000, 1000, timestamp),
+	}
+
+	require.Equal(t, []types.TokenPrice{
+		types.NewTokenPrice("usat", 20000, 1000, timestamp),
+		types.NewTokenPrice("unom", 1.5, 100, timestamp),
+	}, getUnitsPrices(units, pricesByID))
+}
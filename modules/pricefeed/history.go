@@ -0,0 +1,128 @@
+package pricefeed
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/pricefeed/providers"
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	// candlesChunkPeriods represents the number of periods that are aggregated using a single query
+	candlesChunkPeriods = 24
+
+	// historyWindow represents the length of the ranges in which the prices history is queried.
+	// Ranges up to 90 days allow to get the historical prices with an hourly granularity
+	historyWindow = 90 * 24 * time.Hour
+
+	// historyBatchSize represents the maximum number of historical prices stored using a single query
+	historyBatchSize = 1000
+)
+
+// UpdateCandlesInRange computes and stores the token price candles having the given granularity
+// for all the periods between the one containing from and the one containing to (both included).
+// The range is processed in chunks so that each query only scans a limited amount of prices.
+func (m *Module) UpdateCandlesInRange(granularity types.StatsGranularity, from, to time.Time) error {
+	start := granularity.Truncate(from)
+	end := granularity.Truncate(to).Add(granularity.Duration())
+	chunk := candlesChunkPeriods * granularity.Duration()
+
+	for ; start.Before(end); start = start.Add(chunk) {
+		chunkEnd := start.Add(chunk)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+
+		log.Trace().Str("module", "pricefeed").Str("granularity", string(granularity)).
+			Time("from", start).Time("to", chunkEnd).Msg("updating token price candles range")
+
+		err := m.db.UpdateTokenPriceCandles(granularity, start, chunkEnd)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BackfillPricesHistory reads the prices between from and to of all the tokens having a price id using
+// the historical APIs of the configured providers, stores them inside the prices history and then
+// updates the hourly and daily candles of the same range
+func (m *Module) BackfillPricesHistory(from, to time.Time) error {
+	provider, ok := m.provider.(providers.HistoricalPriceProvider)
+	if !ok {
+		return fmt.Errorf("the configured price providers do not support historical prices")
+	}
+
+	units, err := m.db.GetTokenUnitsByPriceID()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range getPriceIDs(units) {
+		for start := from; start.Before(to); start = start.Add(historyWindow) {
+			end := start.Add(historyWindow)
+			if end.After(to) {
+				end = to
+			}
+
+			log.Info().Str("module", "pricefeed").Str("price_id", id).
+				Time("from", start).Time("to", end).Msg("backfilling token prices history")
+
+			prices, err := provider.GetTokenPriceHistory(id, units[id], start, end)
+			if err != nil {
+				return fmt.Errorf("error while getting %s prices history: %s", id, err)
+			}
+
+			if len(prices) == 0 {
+				log.Warn().Str("module", "pricefeed").Str("price_id", id).
+					Time("from", start).Time("to", end).Msg("no historical prices found")
+				continue
+			}
+
+			err = m.savePricesHistory(prices)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, granularity := range []types.StatsGranularity{types.StatsGranularityHour, types.StatsGranularityDay} {
+		err = m.UpdateCandlesInRange(granularity, from, to)
+		if err != nil {
+			return fmt.Errorf("error while updating %s token price candles: %s", granularity, err)
+		}
+	}
+
+	return nil
+}
+
+// savePricesHistory stores the given historical prices in batches, skipping the duplicated ones
+func (m *Module) savePricesHistory(prices []types.TokenPrice) error {
+	seen := make(map[string]bool, len(prices))
+	var unique []types.TokenPrice
+	for _, price := range prices {
+		key := fmt.Sprintf("%s/%d", price.UnitName, price.Timestamp.UnixNano())
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, price)
+		}
+	}
+	prices = unique
+
+	for start := 0; start < len(prices); start += historyBatchSize {
+		end := start + historyBatchSize
+		if end > len(prices) {
+			end = len(prices)
+		}
+
+		err := m.db.SaveTokenPricesHistory(prices[start:end])
+		if err != nil {
+			return fmt.Errorf("error while saving token prices history: %s", err)
+		}
+	}
+	return nil
+}
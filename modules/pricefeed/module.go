@@ -6,6 +6,7 @@ import (
 	"github.com/forbole/njuno/database"
 	"github.com/forbole/njuno/logging"
 	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/modules/pricefeed/providers"
 	"github.com/forbole/njuno/modules/token"
 	source "github.com/forbole/njuno/node"
 	"github.com/forbole/njuno/types/config"
@@ -18,11 +19,12 @@ var (
 
 // Module represents the pricefeed module
 type Module struct {
-	cfg    *token.Config
-	cdc    codec.Marshaler
-	db     database.Database
-	logger logging.Logger
-	source source.Node
+	cfg      *token.Config
+	cdc      codec.Marshaler
+	db       database.Database
+	logger   logging.Logger
+	source   source.Node
+	provider providers.PriceProvider
 }
 
 func NewModule(cfg config.Config, cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node) *Module {
@@ -36,12 +38,27 @@ func NewModule(cfg config.Config, cdc codec.Marshaler, db database.Database, log
 		panic(err)
 	}
 
+	providersCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if providersCfg == nil || len(providersCfg.Providers) == 0 {
+		providersCfg = DefaultConfig()
+	}
+
+	provider, err := providers.NewFallbackProviderFromConfig(providersCfg.Providers)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		cfg:    pricefeedCfg,
-		cdc:    cdc,
-		db:     db,
-		logger: logger,
-		source: source,
+		cfg:      pricefeedCfg,
+		cdc:      cdc,
+		db:       db,
+		logger:   logger,
+		source:   source,
+		provider: provider,
 	}
 }
 
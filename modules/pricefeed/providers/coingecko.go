@@ -0,0 +1,54 @@
+package providers
+
+import (
+	"time"
+
+	"github.com/forbole/njuno/modules/pricefeed/coingecko"
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ HistoricalPriceProvider = &CoinGeckoProvider{}
+)
+
+// CoinGeckoProvider represents a PriceProvider that reads the prices from the CoinGecko APIs
+type CoinGeckoProvider struct {
+	client *coingecko.Client
+}
+
+// NewCoinGeckoProvider builds a new CoinGeckoProvider instance
+func NewCoinGeckoProvider(baseURL, apiKey string, chunkSize int, timeout time.Duration) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		client: coingecko.NewClient(baseURL, apiKey, chunkSize, timeout),
+	}
+}
+
+// Name implements PriceProvider
+func (p *CoinGeckoProvider) Name() string {
+	return TypeCoinGecko
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *CoinGeckoProvider) GetTokensPrices(ids []string) (map[string]types.TokenPrice, error) {
+	tickers, err := p.client.GetMarketTickers(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]types.TokenPrice, len(tickers))
+	for _, ticker := range tickers {
+		prices[ticker.ID] = coingecko.ConvertCoingeckoPrice(ticker)
+	}
+
+	return prices, nil
+}
+
+// GetTokenPriceHistory implements HistoricalPriceProvider
+func (p *CoinGeckoProvider) GetTokenPriceHistory(id string, unitName string, from, to time.Time) ([]types.TokenPrice, error) {
+	chart, err := p.client.GetMarketChartRange(id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return coingecko.ConvertMarketChart(unitName, chart), nil
+}
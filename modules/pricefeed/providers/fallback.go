@@ -0,0 +1,106 @@
+package providers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ HistoricalPriceProvider = &FallbackProvider{}
+)
+
+// FallbackProvider represents a PriceProvider that queries a list of providers in order.
+// Each provider is asked only for the prices that the previous ones could not return,
+// either because they failed or because they do not know the tokens.
+type FallbackProvider struct {
+	providers []PriceProvider
+}
+
+// NewFallbackProvider builds a new FallbackProvider instance
+func NewFallbackProvider(providers ...PriceProvider) *FallbackProvider {
+	return &FallbackProvider{
+		providers: providers,
+	}
+}
+
+// Name implements PriceProvider
+func (p *FallbackProvider) Name() string {
+	names := make([]string, len(p.providers))
+	for i, provider := range p.providers {
+		names[i] = provider.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *FallbackProvider) GetTokensPrices(ids []string) (map[string]types.TokenPrice, error) {
+	prices := make(map[string]types.TokenPrice, len(ids))
+	remaining := ids
+
+	var errs []string
+	for _, provider := range p.providers {
+		if len(remaining) == 0 {
+			break
+		}
+
+		providerPrices, err := provider.GetTokensPrices(remaining)
+		if err != nil {
+			log.Error().Str("module", "pricefeed").Str("provider", provider.Name()).Err(err).
+				Msg("error while getting tokens prices, falling back to the next provider")
+			errs = append(errs, fmt.Sprintf("%s: %s", provider.Name(), err))
+			continue
+		}
+
+		var missing []string
+		for _, id := range remaining {
+			price, found := providerPrices[id]
+			if !found {
+				missing = append(missing, id)
+				continue
+			}
+			prices[id] = price
+		}
+		remaining = missing
+	}
+
+	if len(prices) == 0 && len(errs) > 0 {
+		return nil, fmt.Errorf("error while getting tokens prices from all providers: %s", strings.Join(errs, "; "))
+	}
+
+	return prices, nil
+}
+
+// GetTokenPriceHistory implements HistoricalPriceProvider.
+// The providers that support historical prices are queried in order, until one of them returns some prices.
+func (p *FallbackProvider) GetTokenPriceHistory(id string, unitName string, from, to time.Time) ([]types.TokenPrice, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		historical, ok := provider.(HistoricalPriceProvider)
+		if !ok {
+			continue
+		}
+
+		prices, err := historical.GetTokenPriceHistory(id, unitName, from, to)
+		if err != nil {
+			log.Error().Str("module", "pricefeed").Str("provider", provider.Name()).Err(err).
+				Msg("error while getting token price history, falling back to the next provider")
+			errs = append(errs, fmt.Sprintf("%s: %s", provider.Name(), err))
+			continue
+		}
+
+		if len(prices) > 0 {
+			return prices, nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("error while getting token price history from all providers: %s", strings.Join(errs, "; "))
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,90 @@
+package providers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+// IDsPlaceholder represents the placeholder that is replaced with the comma separated ids inside the HTTP provider url
+const IDsPlaceholder = "{ids}"
+
+var (
+	_ PriceProvider = &HTTPProvider{}
+)
+
+// HTTPProvider represents a PriceProvider that reads the prices from a generic HTTP endpoint returning
+// a JSON list of PriceEntry. The requested ids replace the {ids} placeholder of the url if present,
+// and are sent as the ids query parameter otherwise.
+type HTTPProvider struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewHTTPProvider builds a new HTTPProvider instance
+func NewHTTPProvider(url string, headers map[string]string, timeout time.Duration) *HTTPProvider {
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &HTTPProvider{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Name implements PriceProvider
+func (p *HTTPProvider) Name() string {
+	return TypeHTTP
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *HTTPProvider) GetTokensPrices(ids []string) (map[string]types.TokenPrice, error) {
+	req, err := http.NewRequest(http.MethodGet, p.getURL(ids), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while building prices request: %s", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying prices endpoint: %s", err)
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading prices response body: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prices endpoint returned status %d: %s", resp.StatusCode, bz)
+	}
+
+	return parsePrices(bz, ids, time.Now().UTC())
+}
+
+// getURL returns the url to be queried in order to get the prices of the given ids
+func (p *HTTPProvider) getURL(ids []string) string {
+	joined := url.QueryEscape(strings.Join(ids, ","))
+	if strings.Contains(p.url, IDsPlaceholder) {
+		return strings.ReplaceAll(p.url, IDsPlaceholder, joined)
+	}
+
+	separator := "?"
+	if strings.Contains(p.url, "?") {
+		separator = "&"
+	}
+	return p.url + separator + "ids=" + joined
+}
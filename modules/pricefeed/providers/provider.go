@@ -0,0 +1,156 @@
+package providers
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	TypeCoinGecko = "coingecko"
+	TypeStatic    = "static"
+	TypeHTTP      = "http"
+)
+
+// PriceProvider represents a source from which the tokens prices can be read
+type PriceProvider interface {
+	// Name returns the name of the provider
+	Name() string
+
+	// GetTokensPrices returns the prices of the tokens having the given price ids, indexed by price id.
+	// Ids that are not known by the provider are not included inside the result.
+	// An error is returned if the prices cannot be read.
+	GetTokensPrices(ids []string) (map[string]types.TokenPrice, error)
+}
+
+// HistoricalPriceProvider represents a PriceProvider that can also return the past prices of a token
+type HistoricalPriceProvider interface {
+	PriceProvider
+
+	// GetTokenPriceHistory returns the prices of the token having the given price id between from and to,
+	// using the given unit name. An error is returned if the prices cannot be read.
+	GetTokenPriceHistory(id string, unitName string, from, to time.Time) ([]types.TokenPrice, error)
+}
+
+// Config contains the configuration of a single price provider
+type Config struct {
+	Type string `yaml:"type"`
+
+	// APIKey represents the CoinGecko pro API key. When set, the pro APIs are used
+	APIKey string `yaml:"api_key,omitempty"`
+
+	// ChunkSize represents the maximum number of ids queried with a single CoinGecko request
+	ChunkSize int `yaml:"chunk_size,omitempty"`
+
+	// URL represents the endpoint of the HTTP provider, or a custom CoinGecko APIs base URL
+	URL string `yaml:"url,omitempty"`
+
+	// Headers contains the additional headers sent along with each request of the HTTP provider
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// File represents the path of the file read by the static provider
+	File string `yaml:"file,omitempty"`
+
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// DefaultConfig returns the default provider configuration, which reads the prices from the CoinGecko public APIs
+func DefaultConfig() *Config {
+	return &Config{
+		Type: TypeCoinGecko,
+	}
+}
+
+// NewPriceProvider builds the PriceProvider instance described by the given configuration
+func NewPriceProvider(cfg *Config) (PriceProvider, error) {
+	switch cfg.Type {
+	case TypeCoinGecko:
+		return NewCoinGeckoProvider(cfg.URL, cfg.APIKey, cfg.ChunkSize, cfg.Timeout), nil
+
+	case TypeStatic:
+		if cfg.File == "" {
+			return nil, fmt.Errorf("missing file of the static price provider")
+		}
+		return NewStaticProvider(cfg.File), nil
+
+	case TypeHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("missing url of the http price provider")
+		}
+		return NewHTTPProvider(cfg.URL, cfg.Headers, cfg.Timeout), nil
+
+	default:
+		return nil, fmt.Errorf("invalid price provider type: %s", cfg.Type)
+	}
+}
+
+// NewFallbackProviderFromConfig builds a FallbackProvider that queries the providers described
+// by the given configurations in the same order
+func NewFallbackProviderFromConfig(cfgs []*Config) (*FallbackProvider, error) {
+	providers := make([]PriceProvider, len(cfgs))
+	for i, cfg := range cfgs {
+		provider, err := NewPriceProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		providers[i] = provider
+	}
+
+	return NewFallbackProvider(providers...), nil
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// PriceEntry contains the price of a single token, as read from the static and HTTP providers
+type PriceEntry struct {
+	ID          string    `json:"id" yaml:"id"`
+	Symbol      string    `json:"symbol" yaml:"symbol"`
+	Price       float64   `json:"price" yaml:"price"`
+	MarketCap   float64   `json:"market_cap" yaml:"market_cap"`
+	LastUpdated time.Time `json:"last_updated" yaml:"last_updated"`
+}
+
+// PriceEntries represents a list of PriceEntry wrapped inside the prices field
+type PriceEntries struct {
+	Prices []PriceEntry `json:"prices" yaml:"prices"`
+}
+
+// parsePrices parses the given YAML (or JSON) prices list, returning the prices of the tokens
+// having the given ids. Both a list wrapped inside the prices field and a bare list are supported.
+// Entries without a last update time are considered updated at the given time.
+func parsePrices(bz []byte, ids []string, now time.Time) (map[string]types.TokenPrice, error) {
+	var entries PriceEntries
+	err := yaml.Unmarshal(bz, &entries)
+	if err != nil {
+		// Try parsing the bare list
+		var list []PriceEntry
+		if yaml.Unmarshal(bz, &list) != nil {
+			return nil, fmt.Errorf("error while parsing prices: %s", err)
+		}
+		entries.Prices = list
+	}
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	prices := make(map[string]types.TokenPrice)
+	for _, entry := range entries.Prices {
+		if !wanted[entry.ID] {
+			continue
+		}
+
+		timestamp := entry.LastUpdated
+		if timestamp.IsZero() {
+			timestamp = now
+		}
+
+		prices[entry.ID] = types.NewTokenPrice(entry.Symbol, entry.Price, int64(entry.MarketCap), timestamp)
+	}
+
+	return prices, nil
+}
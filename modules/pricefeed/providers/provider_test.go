@@ -0,0 +1,173 @@
+package providers_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/pricefeed/providers"
+	"github.com/forbole/njuno/types"
+)
+
+// coingeckoServer returns a fake CoinGecko server answering each markets request with one ticker per id
+func coingeckoServer(t *testing.T, apiKey string, requests *int32, rateLimited int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(requests, 1)
+		if count <= rateLimited {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		require.Equal(t, "/coins/markets", r.URL.Path)
+		require.Equal(t, apiKey, r.Header.Get("x-cg-pro-api-key"))
+
+		var tickers []string
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			tickers = append(tickers, fmt.Sprintf(
+				`{"id":"%s","symbol":"%s","current_price":1.5,"market_cap":1000.7,"last_updated":"2022-11-01T00:00:00Z"}`,
+				id, strings.ToUpper(id)))
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(tickers, ",") + "]"))
+	}))
+}
+
+func TestCoinGeckoProvider_GetTokensPrices(t *testing.T) {
+	var requests int32
+	server := coingeckoServer(t, "pro-key", &requests, 0)
+	defer server.Close()
+
+	provider := providers.NewCoinGeckoProvider(server.URL, "pro-key", 2, time.Second)
+	prices, err := provider.GetTokensPrices([]string{"nomic", "bitcoin", "cosmos"})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests, "ids should be split into chunks")
+	require.Len(t, prices, 3)
+	require.Equal(t, types.NewTokenPrice("NOMIC", 1.5, 1000, time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)), prices["nomic"])
+}
+
+func TestCoinGeckoProvider_RateLimit(t *testing.T) {
+	var requests int32
+	server := coingeckoServer(t, "", &requests, 2)
+	defer server.Close()
+
+	provider := providers.NewCoinGeckoProvider(server.URL, "", 0, time.Second)
+	prices, err := provider.GetTokensPrices([]string{"nomic"})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests)
+	require.Contains(t, prices, "nomic")
+
+	requests = 0
+	server = coingeckoServer(t, "", &requests, 10)
+	defer server.Close()
+
+	provider = providers.NewCoinGeckoProvider(server.URL, "", 0, time.Second)
+	_, err = provider.GetTokensPrices([]string{"nomic"})
+	require.Error(t, err)
+}
+
+func TestStaticProvider_GetTokensPrices(t *testing.T) {
+	provider := providers.NewStaticProvider(filepath.Join("testdata", "prices.yaml"))
+	prices, err := provider.GetTokensPrices([]string{"nomic", "unknown"})
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, types.NewTokenPrice("nom", 0.25, 25000000, time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)), prices["nomic"])
+
+	_, err = providers.NewStaticProvider(filepath.Join("testdata", "missing.yaml")).GetTokensPrices([]string{"nomic"})
+	require.Error(t, err)
+}
+
+func TestHTTPProvider_GetTokensPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/prices/nomic,bitcoin", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[{"id":"nomic","symbol":"nom","price":0.3,"market_cap":300},{"id":"bitcoin","symbol":"btc","price":20000}]`))
+	}))
+	defer server.Close()
+
+	provider := providers.NewHTTPProvider(server.URL+"/prices/{ids}", map[string]string{"Authorization": "secret"}, time.Second)
+	prices, err := provider.GetTokensPrices([]string{"nomic", "bitcoin"})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, 0.3, prices["nomic"].Price)
+	require.Equal(t, int64(300), prices["nomic"].MarketCap)
+	require.False(t, prices["bitcoin"].Timestamp.IsZero())
+}
+
+func TestFallbackProvider_GetTokensPrices(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	var requested []string
+	partial := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("ids"))
+		_, _ = w.Write([]byte(`{"prices":[{"id":"bitcoin","symbol":"btc","price":20000}]}`))
+	}))
+	defer partial.Close()
+
+	provider := providers.NewFallbackProvider(
+		providers.NewHTTPProvider(failing.URL, nil, time.Second),
+		providers.NewHTTPProvider(partial.URL, nil, time.Second),
+		providers.NewStaticProvider(filepath.Join("testdata", "prices.yaml")),
+	)
+
+	prices, err := provider.GetTokensPrices([]string{"bitcoin", "nomic", "unknown"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bitcoin,nomic,unknown"}, requested)
+	require.Len(t, prices, 2)
+	require.Equal(t, 20000.0, prices["bitcoin"].Price)
+	require.Equal(t, 0.25, prices["nomic"].Price)
+
+	provider = providers.NewFallbackProvider(providers.NewHTTPProvider(failing.URL, nil, time.Second))
+	_, err = provider.GetTokensPrices([]string{"bitcoin"})
+	require.Error(t, err)
+}
+
+func TestNewPriceProvider(t *testing.T) {
+	_, err := providers.NewPriceProvider(&providers.Config{Type: providers.TypeStatic})
+	require.Error(t, err)
+
+	_, err = providers.NewPriceProvider(&providers.Config{Type: "unknown"})
+	require.Error(t, err)
+
+	provider, err := providers.NewFallbackProviderFromConfig([]*providers.Config{
+		providers.DefaultConfig(),
+		{Type: providers.TypeStatic, File: "prices.yaml"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "coingecko,static", provider.Name())
+}
+
+func TestCoinGeckoProvider_GetTokenPriceHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/coins/nomic/market_chart/range", r.URL.Path)
+		require.Equal(t, "1667260800", r.URL.Query().Get("from"))
+		require.Equal(t, "1667268000", r.URL.Query().Get("to"))
+		_, _ = w.Write([]byte(`{
+			"prices": [[1667260800000, 0.25], [1667264400000, 0.27]],
+			"market_caps": [[1667260800000, 25000000.5], [1667264400000, 27000000]]
+		}`))
+	}))
+	defer server.Close()
+
+	from := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	provider := providers.NewFallbackProvider(
+		providers.NewStaticProvider(filepath.Join("testdata", "prices.yaml")),
+		providers.NewCoinGeckoProvider(server.URL, "", 0, time.Second),
+	)
+
+	prices, err := provider.GetTokenPriceHistory("nomic", "unom", from, from.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenPrice{
+		types.NewTokenPrice("unom", 0.25, 25000000, from),
+		types.NewTokenPrice("unom", 0.27, 27000000, from.Add(time.Hour)),
+	}, prices)
+}
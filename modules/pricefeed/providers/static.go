@@ -0,0 +1,41 @@
+package providers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ PriceProvider = &StaticProvider{}
+)
+
+// StaticProvider represents a PriceProvider that reads manually set prices from a local file.
+// The file is read again on each query, so that it can be updated without restarting the parser.
+type StaticProvider struct {
+	file string
+}
+
+// NewStaticProvider builds a new StaticProvider instance
+func NewStaticProvider(file string) *StaticProvider {
+	return &StaticProvider{
+		file: file,
+	}
+}
+
+// Name implements PriceProvider
+func (p *StaticProvider) Name() string {
+	return TypeStatic
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *StaticProvider) GetTokensPrices(ids []string) (map[string]types.TokenPrice, error) {
+	bz, err := ioutil.ReadFile(p.file)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading prices file: %s", err)
+	}
+
+	return parsePrices(bz, ids, time.Now().UTC())
+}
@@ -0,0 +1,38 @@
+package proposer
+
+import (
+	"fmt"
+
+	"github.com/forbole/njuno/modules/validatorset"
+	"github.com/forbole/njuno/types/config"
+)
+
+// CheckValidatorSetConfig returns an error if the given configuration does not allow to compute the
+// expected proposals. Those are computed by joining the validator set of each block height,
+// so the validator_set module must be enabled and it must store the whole set of each height
+func CheckValidatorSetConfig(cfg config.Config) error {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		return fmt.Errorf("error while reading the config: %s", err)
+	}
+
+	return checkValidatorSetConfig(cfg.Chain, bz)
+}
+
+func checkValidatorSetConfig(chainCfg config.ChainConfig, bz []byte) error {
+	if !chainCfg.IsModuleEnabled("validator_set") {
+		return fmt.Errorf("the proposer module requires the validator_set module to be enabled")
+	}
+
+	validatorSetCfg, err := validatorset.ParseConfig(bz)
+	if err != nil {
+		return fmt.Errorf("error while parsing the validator set config: %s", err)
+	}
+
+	if validatorSetCfg != nil && validatorSetCfg.Mode != validatorset.ModeAll {
+		return fmt.Errorf("the proposer module requires the validator set mode to be %s, got %s",
+			validatorset.ModeAll, validatorSetCfg.Mode)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types/config"
+)
+
+func TestCheckValidatorSetConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		modules   []string
+		bz        string
+		shouldErr bool
+	}{
+		{
+			name:      "validator_set module disabled returns error",
+			modules:   []string{"proposer"},
+			shouldErr: true,
+		},
+		{
+			name:      "default validator set mode is allowed",
+			modules:   []string{"proposer", "validator_set"},
+			shouldErr: false,
+		},
+		{
+			name:      "all validator set mode is allowed",
+			modules:   []string{"proposer", "validator_set"},
+			bz:        "validator_set:\n  mode: all\n",
+			shouldErr: false,
+		},
+		{
+			name:      "diff validator set mode returns error",
+			modules:   []string{"proposer", "validator_set"},
+			bz:        "validator_set:\n  mode: diff\n",
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkValidatorSetConfig(config.NewChainConfig("nomic", tc.modules), []byte(tc.bz))
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package proposer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
+)
+
+// proposerStatsChunkDays represents the number of days that are aggregated using a single query
+const proposerStatsChunkDays = 7
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "proposer").Msg("setting up periodic tasks")
+
+	// Update the proposer stats every 30 mins
+	if _, err := scheduler.Every(30).Minutes().Do(func() {
+		utils.WatchMethod(m.updateProposerStats)
+	}); err != nil {
+		return fmt.Errorf("error while setting up proposer periodic operations: %s", err)
+	}
+
+	return nil
+}
+
+// updateProposerStats incrementally updates the proposer statistics,
+// starting from the latest stored date up to the current one
+func (m *Module) updateProposerStats() error {
+	log.Debug().Str("module", "proposer").Msg("updating proposer stats")
+
+	from, err := m.db.GetLastProposerStatsDate()
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks stored yet
+	if from.IsZero() {
+		return nil
+	}
+
+	return m.UpdateProposerStatsInRange(from, time.Now())
+}
+
+// UpdateProposerStatsInRange computes and stores the proposer statistics for all the dates
+// between the one containing from and the one containing to (both included).
+// The range is processed in chunks so that each query only scans a limited amount of blocks.
+func (m *Module) UpdateProposerStatsInRange(from, to time.Time) error {
+	return utils.ForEachPeriodsChunk(types.StatsGranularityDay, from, to, proposerStatsChunkDays,
+		func(start, end time.Time) error {
+			log.Trace().Str("module", "proposer").Time("from", start).Time("to", end).
+				Msg("updating proposer stats range")
+			return m.db.UpdateProposerStats(start, end)
+		},
+	)
+}
@@ -0,0 +1,38 @@
+package proposer
+
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that keeps the validators proposer statistics updated
+type Module struct {
+	db database.Database
+}
+
+// NewModule builds a new Module instance.
+// It panics if the module is enabled but the validator set is not stored for each height,
+// since the expected proposals would be wrong
+func NewModule(cfg config.Config, db database.Database) *Module {
+	if cfg.Chain.IsModuleEnabled("proposer") {
+		err := CheckValidatorSetConfig(cfg)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return &Module{
+		db: db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "proposer"
+}
@@ -7,14 +7,25 @@ import (
 	"github.com/forbole/njuno/node"
 
 	"github.com/forbole/njuno/modules/actions"
+	"github.com/forbole/njuno/modules/balances"
 	"github.com/forbole/njuno/modules/bank"
+	"github.com/forbole/njuno/modules/bank/supply"
+	"github.com/forbole/njuno/modules/bitcoin"
 	"github.com/forbole/njuno/modules/consensus"
+	"github.com/forbole/njuno/modules/decentralization"
+	"github.com/forbole/njuno/modules/delegations"
 	"github.com/forbole/njuno/modules/ibc"
 	"github.com/forbole/njuno/modules/mint"
 	"github.com/forbole/njuno/modules/pricefeed"
+	"github.com/forbole/njuno/modules/proposer"
+	"github.com/forbole/njuno/modules/richlist"
 	"github.com/forbole/njuno/modules/staking"
+	"github.com/forbole/njuno/modules/stats"
 	"github.com/forbole/njuno/modules/telemetry"
 	"github.com/forbole/njuno/modules/token"
+	"github.com/forbole/njuno/modules/uptime"
+	"github.com/forbole/njuno/modules/validatorset"
+	"github.com/forbole/njuno/modules/verify"
 
 	"github.com/forbole/njuno/logging"
 
@@ -93,17 +104,33 @@ func NewDefaultRegistrar(parser messages.MessageAddressesParser) *DefaultRegistr
 
 // BuildModules implements Registrar
 func (r *DefaultRegistrar) BuildModules(ctx Context) modules.Modules {
+	// Build a single supply provider so that all the modules read the supply from the same sources
+	supplyProvider, err := supply.NewProvider(ctx.NJunoConfig, ctx.Proxy)
+	if err != nil {
+		panic(err)
+	}
+
 	return modules.Modules{
-		actions.NewModule(ctx.NJunoConfig, ctx.EncodingConfig),
-		bank.NewModule(ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
+		actions.NewModule(ctx.NJunoConfig, ctx.EncodingConfig, ctx.Database, supplyProvider),
+		balances.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Proxy, r.parser),
+		bank.NewModule(ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy, supplyProvider),
+		bitcoin.NewModule(ctx.NJunoConfig, ctx.Database),
 		consensus.NewModule(ctx.Database),
+		decentralization.NewModule(ctx.Database),
+		delegations.NewModule(ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Proxy),
 		ibc.NewModule(ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
-		mint.NewModule(ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
+		mint.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy, supplyProvider),
 		pricefeed.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
+		proposer.NewModule(ctx.NJunoConfig, ctx.Database),
 		pruning.NewModule(ctx.NJunoConfig, ctx.Database, ctx.Logger),
-		staking.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
+		richlist.NewModule(ctx.NJunoConfig, ctx.Database, ctx.Proxy),
+		staking.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy, supplyProvider),
+		stats.NewModule(ctx.Database),
 		telemetry.NewModule(ctx.NJunoConfig),
 		token.NewModule(ctx.NJunoConfig, ctx.EncodingConfig.Marshaler, ctx.Database, ctx.Logger, ctx.Proxy),
+		uptime.NewModule(ctx.NJunoConfig, ctx.Database, ctx.Proxy),
+		validatorset.NewModule(ctx.NJunoConfig, ctx.Database, ctx.Proxy),
+		verify.NewModule(ctx.NJunoConfig, ctx.Proxy, ctx.Database),
 	}
 }
 
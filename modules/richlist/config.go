@@ -0,0 +1,133 @@
+package richlist
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConcurrency represents the default number of account balances that are queried concurrently
+const DefaultConcurrency = 10
+
+// DefaultMaxFailedRatio represents the default maximum ratio of accounts whose balance can fail to be read
+const DefaultMaxFailedRatio = 0.01
+
+// LabeledAddress represents an address that should be tagged, or excluded, when computing the rich list
+type LabeledAddress struct {
+	Address string `yaml:"address"`
+	Label   string `yaml:"label"`
+	Exclude bool   `yaml:"exclude,omitempty"`
+}
+
+// Config contains the configuration of the richlist module
+type Config struct {
+	// Interval represents the time between two rich list computations
+	Interval time.Duration `yaml:"interval,omitempty"`
+
+	// Concurrency represents the number of account balances that are queried concurrently
+	Concurrency int `yaml:"concurrency,omitempty"`
+
+	// MaxFailedRatio represents the maximum ratio (between 0 and 1) of accounts whose balance can fail to be read.
+	// If more balances fail, or if the balance of a labeled address fails, the rich list is not updated
+	MaxFailedRatio *float64 `yaml:"max_failed_ratio,omitempty"`
+
+	// TopHolders represents the number of top holders stored for each denom
+	TopHolders int `yaml:"top_holders,omitempty"`
+
+	// Buckets contains the lower bounds of the balance buckets used to count the holders of each denom
+	Buckets []string `yaml:"buckets,omitempty"`
+
+	// LabeledAddresses contains the addresses (exchanges, bridges, community pool, etc.) to be tagged or excluded
+	LabeledAddresses []LabeledAddress `yaml:"labeled_addresses,omitempty"`
+}
+
+// DefaultConfig returns the default richlist configuration
+func DefaultConfig() *Config {
+	return &Config{
+		Interval:    time.Hour,
+		Concurrency: DefaultConcurrency,
+		TopHolders:  100,
+		Buckets:     []string{"1", "1000", "1000000", "1000000000", "1000000000000"},
+	}
+}
+
+// Validate checks whether the configuration is valid
+func (c *Config) Validate() error {
+	if c.Interval < 0 {
+		return fmt.Errorf("invalid richlist interval: %s", c.Interval)
+	}
+
+	if c.Concurrency < 0 {
+		return fmt.Errorf("invalid richlist concurrency: %d", c.Concurrency)
+	}
+
+	if c.MaxFailedRatio != nil && (*c.MaxFailedRatio < 0 || *c.MaxFailedRatio > 1) {
+		return fmt.Errorf("invalid richlist max failed ratio: %f", *c.MaxFailedRatio)
+	}
+
+	if c.TopHolders < 0 {
+		return fmt.Errorf("invalid richlist top holders: %d", c.TopHolders)
+	}
+
+	_, err := c.GetBuckets()
+	if err != nil {
+		return err
+	}
+
+	for _, labeled := range c.LabeledAddresses {
+		if labeled.Address == "" {
+			return fmt.Errorf("missing address of richlist label %s", labeled.Label)
+		}
+	}
+
+	return nil
+}
+
+// GetConcurrency returns the number of account balances that are queried concurrently
+func (c *Config) GetConcurrency() int {
+	if c.Concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return c.Concurrency
+}
+
+// GetMaxFailedRatio returns the maximum ratio of accounts whose balance can fail to be read
+func (c *Config) GetMaxFailedRatio() float64 {
+	if c.MaxFailedRatio == nil {
+		return DefaultMaxFailedRatio
+	}
+	return *c.MaxFailedRatio
+}
+
+// GetBuckets returns the buckets lower bounds as integers
+func (c *Config) GetBuckets() ([]sdk.Int, error) {
+	buckets := make([]sdk.Int, len(c.Buckets))
+	for i, bucket := range c.Buckets {
+		value, ok := sdk.NewIntFromString(bucket)
+		if !ok || value.IsNegative() {
+			return nil, fmt.Errorf("invalid richlist bucket: %s", bucket)
+		}
+		buckets[i] = value
+	}
+	return buckets, nil
+}
+
+// GetLabeledAddresses returns the labeled addresses indexed by address
+func (c *Config) GetLabeledAddresses() map[string]LabeledAddress {
+	labeled := make(map[string]LabeledAddress, len(c.LabeledAddresses))
+	for _, address := range c.LabeledAddresses {
+		labeled[address.Address] = address
+	}
+	return labeled
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"richlist"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -0,0 +1,130 @@
+package richlist
+
+import (
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "richlist").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(m.cfg.Interval).Do(func() {
+		utils.WatchMethod(m.UpdateRichList)
+	}); err != nil {
+		return fmt.Errorf("error while setting up richlist periodic operations: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateRichList reads the balances of all the accounts at the latest height, and stores the
+// rich list and holders distribution computed from them. Accounts whose balance cannot be read are skipped,
+// unless they are too many or they include a labeled address
+func (m *Module) UpdateRichList() error {
+	log.Debug().Str("module", "richlist").Msg("updating rich list")
+
+	height, err := m.source.LatestHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting latest height: %s", err)
+	}
+
+	addresses, err := m.source.Accounts()
+	if err != nil {
+		return fmt.Errorf("error while getting accounts: %s", err)
+	}
+
+	// Read all the balances at the same height so that the results are consistent
+	balances, failed := GetAccountBalances(m.source.AccountBalance, addresses, height, m.cfg.GetConcurrency())
+	if len(addresses) > 0 && len(balances) == 0 {
+		return fmt.Errorf("error while getting balances: no balance could be read")
+	}
+
+	err = CheckFailedAddresses(failed, len(addresses), m.cfg.GetLabeledAddresses(), m.cfg.GetMaxFailedRatio())
+	if err != nil {
+		return fmt.Errorf("error while getting balances: %s", err)
+	}
+
+	supply, _, err := m.db.GetSupply()
+	if err != nil {
+		return fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	buckets, err := m.cfg.GetBuckets()
+	if err != nil {
+		return err
+	}
+
+	richList := ComputeRichList(balances, supply, m.cfg.GetLabeledAddresses(), m.cfg.TopHolders, buckets, height)
+	return m.db.SaveRichList(richList)
+}
+
+// GetAccountBalances reads the balances of the given addresses at the given height using the provided function,
+// querying at most concurrency balances at a time. The accounts whose balance cannot be read are logged and
+// returned separately. The returned balances and failed addresses follow the order of the given addresses.
+func GetAccountBalances(
+	getBalance func(address string, height int64) (sdk.Coins, error), addresses []string, height int64, concurrency int,
+) (balances []types.AccountBalance, failed []string) {
+	results := make([]*types.AccountBalance, len(addresses))
+	indexes := make(chan int)
+
+	var waitGroup sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for index := range indexes {
+				address := addresses[index]
+				balance, err := getBalance(address, height)
+				if err != nil {
+					log.Error().Str("module", "richlist").Err(err).Int64("height", height).
+						Str("address", address).Msg("error while getting account balance, skipping it")
+					continue
+				}
+
+				accountBalance := types.NewAccountBalance(address, balance, height)
+				results[index] = &accountBalance
+			}
+		}()
+	}
+
+	for index := range addresses {
+		indexes <- index
+	}
+	close(indexes)
+	waitGroup.Wait()
+
+	balances = make([]types.AccountBalance, 0, len(results))
+	for index, result := range results {
+		if result == nil {
+			failed = append(failed, addresses[index])
+			continue
+		}
+		balances = append(balances, *result)
+	}
+	return balances, failed
+}
+
+// CheckFailedAddresses returns an error if the balance of any labeled address could not be read,
+// or if the ratio of the failed addresses over the total ones is greater than maxFailedRatio.
+// Storing a rich list computed from such partial balances would misplace the top holders
+func CheckFailedAddresses(failed []string, total int, labeled map[string]LabeledAddress, maxFailedRatio float64) error {
+	for _, address := range failed {
+		if _, ok := labeled[address]; ok {
+			return fmt.Errorf("balance of labeled address %s could not be read", address)
+		}
+	}
+
+	if total > 0 && float64(len(failed))/float64(total) > maxFailedRatio {
+		return fmt.Errorf("balances of %d accounts out of %d could not be read", len(failed), total)
+	}
+
+	return nil
+}
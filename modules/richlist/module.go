@@ -0,0 +1,64 @@
+package richlist
+
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that computes the rich list and the holders distribution of all the denoms
+type Module struct {
+	cfg    *Config
+	db     database.Database
+	source node.Node
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db database.Database, source node.Node) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	richListCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if richListCfg == nil {
+		richListCfg = DefaultConfig()
+	}
+
+	defaultCfg := DefaultConfig()
+	if richListCfg.Interval == 0 {
+		richListCfg.Interval = defaultCfg.Interval
+	}
+	if richListCfg.TopHolders == 0 {
+		richListCfg.TopHolders = defaultCfg.TopHolders
+	}
+	if len(richListCfg.Buckets) == 0 {
+		richListCfg.Buckets = defaultCfg.Buckets
+	}
+
+	err = richListCfg.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg:    richListCfg,
+		db:     db,
+		source: source,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "richlist"
+}
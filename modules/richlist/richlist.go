@@ -0,0 +1,141 @@
+package richlist
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// holder represents the balance of a single denom held by an account
+type holder struct {
+	address string
+	amount  sdk.Int
+}
+
+// ComputeRichList computes the top holders, the holders buckets and the holders distribution of each denom
+// held by the given accounts. Excluded labeled addresses are not considered as holders, while the other labeled
+// addresses are tagged with their label. Shares are computed over the given supply, or over the sum of all the
+// balances if the denom supply is unknown.
+func ComputeRichList(
+	balances []types.AccountBalance, supply sdk.Coins, labeled map[string]LabeledAddress,
+	topHolders int, buckets []sdk.Int, height int64,
+) types.RichList {
+	holders := map[string][]holder{}
+	held := map[string]sdk.Int{}
+	for _, balance := range balances {
+		for _, coin := range balance.Balance {
+			if !coin.Amount.IsPositive() {
+				continue
+			}
+
+			if total, ok := held[coin.Denom]; ok {
+				held[coin.Denom] = total.Add(coin.Amount)
+			} else {
+				held[coin.Denom] = coin.Amount
+			}
+
+			if label, ok := labeled[balance.Address]; ok && label.Exclude {
+				continue
+			}
+
+			holders[coin.Denom] = append(holders[coin.Denom], holder{address: balance.Address, amount: coin.Amount})
+		}
+	}
+
+	denoms := make([]string, 0, len(held))
+	for denom := range held {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	sortedBuckets := make([]sdk.Int, len(buckets))
+	copy(sortedBuckets, buckets)
+	sort.Slice(sortedBuckets, func(i, j int) bool { return sortedBuckets[i].LT(sortedBuckets[j]) })
+
+	richList := types.RichList{Height: height}
+	for _, denom := range denoms {
+		denomHolders := holders[denom]
+		sort.Slice(denomHolders, func(i, j int) bool {
+			if !denomHolders[i].amount.Equal(denomHolders[j].amount) {
+				return denomHolders[i].amount.GT(denomHolders[j].amount)
+			}
+			return denomHolders[i].address < denomHolders[j].address
+		})
+
+		denomSupply := supply.AmountOf(denom)
+		if !denomSupply.IsPositive() {
+			denomSupply = held[denom]
+		}
+
+		for i := 0; i < len(denomHolders) && i < topHolders; i++ {
+			richList.Entries = append(richList.Entries, types.NewRichListEntry(
+				denom, int64(i+1), denomHolders[i].address, denomHolders[i].amount,
+				share(denomHolders[i].amount, denomSupply), labeled[denomHolders[i].address].Label,
+			))
+		}
+
+		richList.Buckets = append(richList.Buckets, computeBuckets(denom, denomHolders, sortedBuckets)...)
+
+		totalAmount := sumTop(denomHolders, len(denomHolders))
+		richList.Distributions = append(richList.Distributions, types.HoldersDistribution{
+			Denom:        denom,
+			HoldersCount: int64(len(denomHolders)),
+			TotalAmount:  totalAmount,
+			Supply:       denomSupply,
+			Top1Share:    share(sumTop(denomHolders, 1), denomSupply),
+			Top10Share:   share(sumTop(denomHolders, 10), denomSupply),
+			Top100Share:  share(sumTop(denomHolders, 100), denomSupply),
+		})
+	}
+
+	return richList
+}
+
+// computeBuckets counts the given holders, sorted by descending amount, inside the given buckets,
+// whose lower bounds are sorted in ascending order
+func computeBuckets(denom string, holders []holder, bounds []sdk.Int) []types.HoldersBucket {
+	buckets := make([]types.HoldersBucket, len(bounds))
+	for i, bound := range bounds {
+		buckets[i] = types.HoldersBucket{
+			Denom:       denom,
+			MinAmount:   bound,
+			TotalAmount: sdk.ZeroInt(),
+		}
+		if i+1 < len(bounds) {
+			maxAmount := bounds[i+1]
+			buckets[i].MaxAmount = &maxAmount
+		}
+	}
+
+	for _, h := range holders {
+		// Find the highest bucket whose lower bound is not greater than the holder amount
+		index := sort.Search(len(bounds), func(i int) bool { return bounds[i].GT(h.amount) }) - 1
+		if index < 0 {
+			continue
+		}
+
+		buckets[index].HoldersCount++
+		buckets[index].TotalAmount = buckets[index].TotalAmount.Add(h.amount)
+	}
+
+	return buckets
+}
+
+// sumTop returns the sum of the amounts of the first n holders
+func sumTop(holders []holder, n int) sdk.Int {
+	sum := sdk.ZeroInt()
+	for i := 0; i < len(holders) && i < n; i++ {
+		sum = sum.Add(holders[i].amount)
+	}
+	return sum
+}
+
+// share returns the given amount over the given total, or zero if the total is not positive
+func share(amount, total sdk.Int) sdk.Dec {
+	if !total.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return amount.ToDec().Quo(total.ToDec())
+}
@@ -0,0 +1,173 @@
+package richlist_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/richlist"
+	"github.com/forbole/njuno/types"
+)
+
+func TestComputeRichList(t *testing.T) {
+	balances := []types.AccountBalance{
+		types.NewAccountBalance("address1", sdk.NewCoins(sdk.NewInt64Coin("unom", 500)), 10),
+		types.NewAccountBalance("address2", sdk.NewCoins(sdk.NewInt64Coin("unom", 200), sdk.NewInt64Coin("usat", 5)), 10),
+		types.NewAccountBalance("address3", sdk.NewCoins(sdk.NewInt64Coin("unom", 50)), 10),
+		types.NewAccountBalance("exchange", sdk.NewCoins(sdk.NewInt64Coin("unom", 150)), 10),
+		types.NewAccountBalance("bridge", sdk.NewCoins(sdk.NewInt64Coin("unom", 100)), 10),
+		types.NewAccountBalance("empty", sdk.NewCoins(), 10),
+	}
+	labeled := map[string]richlist.LabeledAddress{
+		"exchange": {Address: "exchange", Label: "exchange"},
+		"bridge":   {Address: "bridge", Label: "bridge", Exclude: true},
+	}
+	supply := sdk.NewCoins(sdk.NewInt64Coin("unom", 1000))
+	buckets := []sdk.Int{sdk.NewInt(100), sdk.NewInt(1)}
+
+	richList := richlist.ComputeRichList(balances, supply, labeled, 3, buckets, 10)
+	require.Equal(t, int64(10), richList.Height)
+
+	// Top holders, with the excluded address being ignored and the labeled one being tagged
+	require.Len(t, richList.Entries, 4)
+	require.Equal(t, types.NewRichListEntry("unom", 1, "address1", sdk.NewInt(500), sdk.MustNewDecFromStr("0.5"), ""), richList.Entries[0])
+	require.Equal(t, types.NewRichListEntry("unom", 2, "address2", sdk.NewInt(200), sdk.MustNewDecFromStr("0.2"), ""), richList.Entries[1])
+	require.Equal(t, types.NewRichListEntry("unom", 3, "exchange", sdk.NewInt(150), sdk.MustNewDecFromStr("0.15"), "exchange"), richList.Entries[2])
+
+	// Denoms without a known supply use the held amount
+	require.Equal(t, types.NewRichListEntry("usat", 1, "address2", sdk.NewInt(5), sdk.OneDec(), ""), richList.Entries[3])
+
+	// Buckets are sorted by lower bound
+	require.Len(t, richList.Buckets, 4)
+	require.Equal(t, sdk.NewInt(1), richList.Buckets[0].MinAmount)
+	require.Equal(t, sdk.NewInt(100), *richList.Buckets[0].MaxAmount)
+	require.Equal(t, int64(1), richList.Buckets[0].HoldersCount)
+	require.Equal(t, sdk.NewInt(50), richList.Buckets[0].TotalAmount)
+	require.Nil(t, richList.Buckets[1].MaxAmount)
+	require.Equal(t, int64(3), richList.Buckets[1].HoldersCount)
+	require.Equal(t, sdk.NewInt(850), richList.Buckets[1].TotalAmount)
+
+	// Distribution
+	require.Len(t, richList.Distributions, 2)
+	distribution := richList.Distributions[0]
+	require.Equal(t, "unom", distribution.Denom)
+	require.Equal(t, int64(4), distribution.HoldersCount)
+	require.Equal(t, sdk.NewInt(900), distribution.TotalAmount)
+	require.Equal(t, sdk.NewInt(1000), distribution.Supply)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), distribution.Top1Share)
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"), distribution.Top10Share)
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"), distribution.Top100Share)
+}
+
+func TestGetAccountBalances(t *testing.T) {
+	addresses := []string{"address1", "failing", "address2", "address3"}
+
+	var mu sync.Mutex
+	var calls int
+	getBalance := func(address string, height int64) (sdk.Coins, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		require.Equal(t, int64(10), height)
+		if address == "failing" {
+			return nil, fmt.Errorf("error")
+		}
+		return sdk.NewCoins(sdk.NewInt64Coin("unom", int64(len(address)))), nil
+	}
+
+	balances, failed := richlist.GetAccountBalances(getBalance, addresses, 10, 2)
+	require.Equal(t, 4, calls)
+	require.Equal(t, []string{"failing"}, failed)
+	require.Equal(t, []types.AccountBalance{
+		types.NewAccountBalance("address1", sdk.NewCoins(sdk.NewInt64Coin("unom", 8)), 10),
+		types.NewAccountBalance("address2", sdk.NewCoins(sdk.NewInt64Coin("unom", 8)), 10),
+		types.NewAccountBalance("address3", sdk.NewCoins(sdk.NewInt64Coin("unom", 8)), 10),
+	}, balances)
+
+	balances, failed = richlist.GetAccountBalances(getBalance, nil, 10, 2)
+	require.Empty(t, balances)
+	require.Empty(t, failed)
+}
+
+func TestCheckFailedAddresses(t *testing.T) {
+	labeled := map[string]richlist.LabeledAddress{
+		"exchange": {Address: "exchange", Label: "Exchange"},
+	}
+
+	testCases := []struct {
+		name      string
+		failed    []string
+		total     int
+		maxRatio  float64
+		shouldErr bool
+	}{
+		{
+			name:      "no failed addresses returns no error",
+			total:     10,
+			maxRatio:  0,
+			shouldErr: false,
+		},
+		{
+			name:      "failed ratio below the threshold returns no error",
+			failed:    []string{"address1"},
+			total:     10,
+			maxRatio:  0.1,
+			shouldErr: false,
+		},
+		{
+			name:      "failed ratio above the threshold returns error",
+			failed:    []string{"address1", "address2"},
+			total:     10,
+			maxRatio:  0.1,
+			shouldErr: true,
+		},
+		{
+			name:      "failed labeled address returns error",
+			failed:    []string{"exchange"},
+			total:     1000,
+			maxRatio:  0.5,
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := richlist.CheckFailedAddresses(tc.failed, tc.total, labeled, tc.maxRatio)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_GetConcurrency(t *testing.T) {
+	cfg := richlist.DefaultConfig()
+	require.Equal(t, richlist.DefaultConcurrency, cfg.GetConcurrency())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Concurrency = 0
+	require.Equal(t, richlist.DefaultConcurrency, cfg.GetConcurrency())
+
+	cfg.Concurrency = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestConfig_GetMaxFailedRatio(t *testing.T) {
+	cfg := richlist.DefaultConfig()
+	require.Equal(t, richlist.DefaultMaxFailedRatio, cfg.GetMaxFailedRatio())
+
+	ratio := 0.0
+	cfg.MaxFailedRatio = &ratio
+	require.Equal(t, 0.0, cfg.GetMaxFailedRatio())
+	require.NoError(t, cfg.Validate())
+
+	ratio = 1.5
+	require.Error(t, cfg.Validate())
+}
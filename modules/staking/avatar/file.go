@@ -0,0 +1,41 @@
+package avatar
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	_ AvatarProvider = &FileProvider{}
+)
+
+// FileProvider represents an AvatarProvider that resolves the avatars using a static YAML (or JSON) file
+// mapping each validator identity to its avatar URL
+type FileProvider struct {
+	avatars map[string]string
+}
+
+// NewFileProvider builds a new FileProvider instance reading the mapping contained inside the given file
+func NewFileProvider(path string) (*FileProvider, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading avatars file: %s", err)
+	}
+
+	var avatars map[string]string
+	err = yaml.Unmarshal(bz, &avatars)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling avatars file: %s", err)
+	}
+
+	return &FileProvider{
+		avatars: avatars,
+	}, nil
+}
+
+// GetAvatarURL implements AvatarProvider
+func (p *FileProvider) GetAvatarURL(identity string) (string, error) {
+	return p.avatars[identity], nil
+}
@@ -0,0 +1,92 @@
+package avatar
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultKeybaseURL is the base URL of the Keybase APIs
+const DefaultKeybaseURL = "https://keybase.io/_/api/1.0"
+
+var (
+	_ AvatarProvider = &KeybaseProvider{}
+)
+
+// KeybaseProvider represents an AvatarProvider that resolves the avatars using the Keybase APIs,
+// treating the validator identity as the suffix of a Keybase key
+type KeybaseProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewKeybaseProvider builds a new KeybaseProvider instance
+func NewKeybaseProvider(baseURL string, timeout time.Duration) *KeybaseProvider {
+	return &KeybaseProvider{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// GetAvatarURL implements AvatarProvider
+func (p *KeybaseProvider) GetAvatarURL(identity string) (string, error) {
+	if len(identity) < 15 {
+		return "", nil
+	}
+
+	var response IdentityQueryResponse
+	endpoint := fmt.Sprintf("/user/lookup.json?key_suffix=%s&fields=basics&fields=pictures", url.QueryEscape(identity))
+	err := p.query(endpoint, &response)
+	if err != nil {
+		return "", fmt.Errorf("error while querying keybase: %s", err)
+	}
+
+	// The server responded with an error
+	if response.Status.Code != 0 {
+		return "", fmt.Errorf("response code not valid: %s", response.Status.ErrDesc)
+	}
+
+	// No images found
+	if len(response.Objects) == 0 {
+		return "", nil
+	}
+
+	// Either the pictures do not exist, or the primary one does not exist, or the URL is empty
+	data := response.Objects[0]
+	if data.Pictures == nil || data.Pictures.Primary == nil || len(data.Pictures.Primary.URL) == 0 {
+		return "", nil
+	}
+
+	// The picture URL is found
+	return data.Pictures.Primary.URL, nil
+}
+
+// query queries the Keybase APIs for the given endpoint, and de-serializes
+// the response as a JSON object inside the given ptr
+func (p *KeybaseProvider) query(endpoint string, ptr interface{}) error {
+	resp, err := p.client.Get(p.baseURL + endpoint)
+	if err != nil {
+		return fmt.Errorf("error while querying keybase APIs: %s", err)
+	}
+
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error while reading response body: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("keybase APIs returned status %d: %s", resp.StatusCode, bz)
+	}
+
+	err = json.Unmarshal(bz, ptr)
+	if err != nil {
+		return fmt.Errorf("error while unmarshaling response body: %s", err)
+	}
+
+	return nil
+}
@@ -1,4 +1,4 @@
-package keybase
+package avatar
 
 // QueryStatus contains the details of the status of a request
 type QueryStatus struct {
@@ -0,0 +1,74 @@
+package avatar
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	TypeKeybase  = "keybase"
+	TypeFile     = "file"
+	TypeTemplate = "template"
+)
+
+// AvatarProvider represents a source from which the avatar of a validator can be resolved
+type AvatarProvider interface {
+	// GetAvatarURL returns the avatar URL associated with the given validator identity.
+	// If no avatar is found, an empty string is returned instead.
+	// An error is returned if the lookup fails.
+	GetAvatarURL(identity string) (string, error)
+}
+
+// Config contains the configuration of the provider used to resolve the validators avatar
+type Config struct {
+	Type        string        `yaml:"type"`
+	KeybaseURL  string        `yaml:"keybase_url,omitempty"`
+	FilePath    string        `yaml:"file_path,omitempty"`
+	URLTemplate string        `yaml:"url_template,omitempty"`
+	Timeout     time.Duration `yaml:"timeout,omitempty"`
+	CacheTTL    time.Duration `yaml:"cache_ttl,omitempty"`
+	Interval    time.Duration `yaml:"interval,omitempty"`
+}
+
+// DefaultConfig returns the default provider configuration, which resolves the avatars using Keybase
+func DefaultConfig() *Config {
+	return &Config{
+		Type:       TypeKeybase,
+		KeybaseURL: DefaultKeybaseURL,
+		Timeout:    10 * time.Second,
+		CacheTTL:   24 * time.Hour,
+		Interval:   10 * time.Minute,
+	}
+}
+
+// NewAvatarProvider builds the AvatarProvider instance described by the given configuration
+func NewAvatarProvider(cfg *Config) (AvatarProvider, error) {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	switch cfg.Type {
+	case TypeKeybase:
+		keybaseURL := cfg.KeybaseURL
+		if keybaseURL == "" {
+			keybaseURL = DefaultKeybaseURL
+		}
+		return NewKeybaseProvider(keybaseURL, timeout), nil
+
+	case TypeFile:
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("missing file path of the avatar file provider")
+		}
+		return NewFileProvider(cfg.FilePath)
+
+	case TypeTemplate:
+		if cfg.URLTemplate == "" {
+			return nil, fmt.Errorf("missing url template of the avatar template provider")
+		}
+		return NewTemplateProvider(cfg.URLTemplate), nil
+
+	default:
+		return nil, fmt.Errorf("invalid avatar provider type: %s", cfg.Type)
+	}
+}
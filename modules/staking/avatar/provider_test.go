@@ -0,0 +1,126 @@
+package avatar_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/staking/avatar"
+)
+
+// newFakeKeybaseServer returns a server answering to the Keybase lookup endpoint
+// using the given identities to pictures mapping
+func newFakeKeybaseServer(pictures map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/lookup.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		identity := r.URL.Query().Get("key_suffix")
+		switch identity {
+		case "FAILINGIDENTITY00":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+
+		case "INVALIDIDENTITY00":
+			fmt.Fprint(w, `{"status":{"code":100,"name":"INPUT_ERROR","desc":"bad key_suffix"}}`)
+			return
+		}
+
+		picture, ok := pictures[identity]
+		if !ok {
+			fmt.Fprint(w, `{"status":{"code":0,"name":"OK"},"them":[]}`)
+			return
+		}
+
+		fmt.Fprintf(w, `{"status":{"code":0,"name":"OK"},"them":[{"id":"1","pictures":{"primary":{"url":"%s"}}}]}`, picture)
+	}))
+}
+
+func TestKeybaseProvider_GetAvatarURL(t *testing.T) {
+	server := newFakeKeybaseServer(map[string]string{
+		"2EF8CD9F8F4F3B5E": "https://example.com/forbole.png",
+	})
+	defer server.Close()
+
+	provider := avatar.NewKeybaseProvider(server.URL, time.Second)
+
+	url, err := provider.GetAvatarURL("2EF8CD9F8F4F3B5E")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/forbole.png", url)
+
+	// Unknown identity
+	url, err = provider.GetAvatarURL("0000000000000000")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	// Identity too short to be a key suffix
+	url, err = provider.GetAvatarURL("forbole")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	_, err = provider.GetAvatarURL("FAILINGIDENTITY00")
+	require.Error(t, err)
+
+	_, err = provider.GetAvatarURL("INVALIDIDENTITY00")
+	require.Error(t, err)
+}
+
+func TestKeybaseProvider_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	provider := avatar.NewKeybaseProvider(server.URL, 50*time.Millisecond)
+	_, err := provider.GetAvatarURL("2EF8CD9F8F4F3B5E")
+	require.Error(t, err)
+}
+
+func TestFileProvider_GetAvatarURL(t *testing.T) {
+	provider, err := avatar.NewFileProvider(filepath.Join("testdata", "avatars.yaml"))
+	require.NoError(t, err)
+
+	url, err := provider.GetAvatarURL("2EF8CD9F8F4F3B5E")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/forbole.png", url)
+
+	url, err = provider.GetAvatarURL("0000000000000000")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	_, err = avatar.NewFileProvider(filepath.Join("testdata", "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestTemplateProvider_GetAvatarURL(t *testing.T) {
+	provider := avatar.NewTemplateProvider("https://avatars.example.com/{identity}.png")
+
+	url, err := provider.GetAvatarURL("2EF8CD9F8F4F3B5E")
+	require.NoError(t, err)
+	require.Equal(t, "https://avatars.example.com/2EF8CD9F8F4F3B5E.png", url)
+
+	url, err = provider.GetAvatarURL("")
+	require.NoError(t, err)
+	require.Empty(t, url)
+}
+
+func TestNewAvatarProvider(t *testing.T) {
+	_, err := avatar.NewAvatarProvider(avatar.DefaultConfig())
+	require.NoError(t, err)
+
+	_, err = avatar.NewAvatarProvider(&avatar.Config{Type: avatar.TypeTemplate})
+	require.Error(t, err)
+
+	_, err = avatar.NewAvatarProvider(&avatar.Config{Type: avatar.TypeFile})
+	require.Error(t, err)
+
+	_, err = avatar.NewAvatarProvider(&avatar.Config{Type: "unknown"})
+	require.Error(t, err)
+}
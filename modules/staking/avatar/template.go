@@ -0,0 +1,35 @@
+package avatar
+
+import (
+	"net/url"
+	"strings"
+)
+
+// IdentityPlaceholder is the placeholder replaced with the validator identity inside the URL templates
+const IdentityPlaceholder = "{identity}"
+
+var (
+	_ AvatarProvider = &TemplateProvider{}
+)
+
+// TemplateProvider represents an AvatarProvider that builds the avatar URLs by replacing
+// the identity placeholder of a URL template
+type TemplateProvider struct {
+	template string
+}
+
+// NewTemplateProvider builds a new TemplateProvider instance
+func NewTemplateProvider(template string) *TemplateProvider {
+	return &TemplateProvider{
+		template: template,
+	}
+}
+
+// GetAvatarURL implements AvatarProvider
+func (p *TemplateProvider) GetAvatarURL(identity string) (string, error) {
+	if identity == "" {
+		return "", nil
+	}
+
+	return strings.ReplaceAll(p.template, IdentityPlaceholder, url.PathEscape(identity)), nil
+}
@@ -0,0 +1,85 @@
+package staking
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/types"
+)
+
+// getCachedAvatars returns the identity to avatar URL mapping of all the avatars cached inside the database
+func (m *Module) getCachedAvatars() (map[string]string, error) {
+	cached, err := m.db.GetValidatorsAvatars()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting cached validators avatars: %s", err)
+	}
+
+	avatars := make(map[string]string, len(cached))
+	for _, avatar := range cached {
+		avatars[avatar.Identity] = avatar.AvatarURL
+	}
+
+	return avatars, nil
+}
+
+// updateValidatorsAvatars resolves the avatars of the stored validators identities that are not cached yet
+// or whose cache entry is expired, and stores them inside the database
+func (m *Module) updateValidatorsAvatars() error {
+	log.Debug().Str("module", "staking").Str("operation", "avatars").
+		Msg("updating validators avatars")
+
+	descriptions, err := m.db.GetValidatorsDescription()
+	if err != nil {
+		return fmt.Errorf("error while getting validators description: %s", err)
+	}
+
+	cached, err := m.db.GetValidatorsAvatars()
+	if err != nil {
+		return fmt.Errorf("error while getting cached validators avatars: %s", err)
+	}
+
+	cachedMap := make(map[string]types.ValidatorAvatar, len(cached))
+	for _, avatar := range cached {
+		cachedMap[avatar.Identity] = avatar
+	}
+
+	now := time.Now().UTC()
+	resolved := map[string]bool{}
+	for _, description := range descriptions {
+		identity := description.Identity
+		if identity == "" || resolved[identity] {
+			continue
+		}
+		resolved[identity] = true
+
+		avatar, found := cachedMap[identity]
+		if found && !avatar.IsExpired(m.avatarCfg.CacheTTL, now) {
+			// Make sure validators that took an already cached identity get its avatar
+			if description.AvatarURL != avatar.AvatarURL {
+				err = m.db.SaveValidatorAvatar(avatar)
+				if err != nil {
+					log.Error().Str("module", "staking").Err(err).Str("identity", identity).
+						Msg("error while saving validator avatar")
+				}
+			}
+			continue
+		}
+
+		avatarURL, err := m.avatarProvider.GetAvatarURL(identity)
+		if err != nil {
+			log.Error().Str("module", "staking").Err(err).Str("identity", identity).
+				Msg("error while getting validator avatar url")
+			continue
+		}
+
+		err = m.db.SaveValidatorAvatar(types.NewValidatorAvatar(identity, avatarURL, now))
+		if err != nil {
+			log.Error().Str("module", "staking").Err(err).Str("identity", identity).
+				Msg("error while saving validator avatar")
+		}
+	}
+
+	return nil
+}
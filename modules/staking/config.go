@@ -0,0 +1,84 @@
+package staking
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/njuno/modules/staking/avatar"
+	"github.com/forbole/njuno/modules/staking/source"
+	"github.com/forbole/njuno/types/config"
+)
+
+// Config contains the configuration of the staking module
+type Config struct {
+	ValidatorsSource *source.Config `yaml:"validators_source"`
+	Avatar           *avatar.Config `yaml:"avatar"`
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"staking"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
+
+// NewValidatorSource builds the validators source configured inside the given configuration.
+// If no source is configured, the validators are read using the nomic CLI.
+func NewValidatorSource(cfg config.Config) (source.ValidatorSource, error) {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	stakingCfg, err := ParseConfig(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceCfg := source.DefaultConfig()
+	if stakingCfg != nil && stakingCfg.ValidatorsSource != nil {
+		sourceCfg = stakingCfg.ValidatorsSource
+	}
+
+	// Default to the validators list file set inside the parser config
+	if sourceCfg.Type == source.TypeFile && sourceCfg.FilePath == "" {
+		sourceCfg.FilePath = config.Cfg.Parser.ValidatorsListFilePath
+	}
+
+	return source.NewValidatorSource(sourceCfg)
+}
+
+// NewAvatarProvider builds the avatar provider configured inside the given configuration, returning it
+// along with its configuration. If no provider is configured, the avatars are resolved using Keybase.
+func NewAvatarProvider(cfg config.Config) (avatar.AvatarProvider, *avatar.Config, error) {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stakingCfg, err := ParseConfig(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatarCfg := avatar.DefaultConfig()
+	if stakingCfg != nil && stakingCfg.Avatar != nil {
+		avatarCfg = stakingCfg.Avatar
+	}
+
+	defaultCfg := avatar.DefaultConfig()
+	if avatarCfg.CacheTTL <= 0 {
+		avatarCfg.CacheTTL = defaultCfg.CacheTTL
+	}
+	if avatarCfg.Interval <= 0 {
+		avatarCfg.Interval = defaultCfg.Interval
+	}
+
+	provider, err := avatar.NewAvatarProvider(avatarCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, avatarCfg, nil
+}
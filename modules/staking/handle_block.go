@@ -2,63 +2,200 @@ package staking
 
 import (
 	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
 
-	types "github.com/forbole/njuno/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/rs/zerolog/log"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	types "github.com/forbole/njuno/types"
 )
 
 // HandleBlock implements modules.BlockModule
 func (m *Module) HandleBlock(
-	block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, vals *tmctypes.ResultValidators,
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, vals *tmctypes.ResultValidators,
 ) error {
 
-	// Update double sign evidences
-	go m.updateDoubleSignEvidence(block.Block.Height, block.Block.Evidence.Evidence)
+	// Update the evidences and the penalties they caused
+	go m.updateEvidenceAndSlashing(block.Block.Height, block.Block.Evidence.Evidence, results)
 
 	return nil
 }
 
-// updateDoubleSignEvidence updates the double sign evidence of all validators
-func (m *Module) updateDoubleSignEvidence(height int64, evidenceList tmtypes.EvidenceList) {
+// updateEvidenceAndSlashing stores the evidences included inside the block having the given height,
+// and then the validators slash and jail events emitted by the block
+func (m *Module) updateEvidenceAndSlashing(
+	height int64, evidenceList tmtypes.EvidenceList, results *tmctypes.ResultBlockResults,
+) {
+	// The evidences need to be stored first so that the penalties can be linked to them
+	m.updateEvidence(height, evidenceList)
+
+	if results == nil {
+		return
+	}
+
+	var events []abci.Event
+	events = append(events, results.BeginBlockEvents...)
+	events = append(events, results.EndBlockEvents...)
+	slashing := parseSlashingEvents(height, events)
+	if len(slashing) == 0 {
+		return
+	}
+
+	err := m.db.SaveValidatorsSlashing(slashing)
+	if err != nil {
+		log.Error().Str("module", "staking").Err(err).Int64("height", height).
+			Msg("error while saving validators slashing")
+	}
+}
+
+// updateEvidence stores all the evidences included inside the block having the given height
+func (m *Module) updateEvidence(height int64, evidenceList tmtypes.EvidenceList) {
 	log.Debug().Str("module", "staking").Int64("height", height).
-		Msg("updating double sign evidence")
+		Msg("updating evidence")
 
 	for _, ev := range evidenceList {
-		dve, ok := ev.(*tmtypes.DuplicateVoteEvidence)
-		if !ok {
+		var err error
+		switch evidence := ev.(type) {
+		case *tmtypes.DuplicateVoteEvidence:
+			err = m.db.SaveDoubleSignEvidence(convertDuplicateVoteEvidence(height, evidence))
+
+		case *tmtypes.LightClientAttackEvidence:
+			err = m.db.SaveLightClientAttackEvidence(convertLightClientAttackEvidence(height, evidence))
+
+		default:
+			err = fmt.Errorf("unsupported evidence type %T", ev)
+		}
+
+		// Keep going so that a single failure does not prevent the other evidences from being stored
+		if err != nil {
+			log.Error().Str("module", "staking").Err(err).Int64("height", height).
+				Str("evidence", fmt.Sprintf("%X", ev.Hash())).Msg("error while saving evidence")
+		}
+	}
+}
+
+// convertDuplicateVoteEvidence converts the given tendermint evidence into a DoubleSignEvidence
+func convertDuplicateVoteEvidence(height int64, dve *tmtypes.DuplicateVoteEvidence) types.DoubleSignEvidence {
+	return types.NewDoubleSignEvidence(
+		height,
+		types.NewDoubleSignVote(
+			int(dve.VoteA.Type),
+			dve.VoteA.Height,
+			dve.VoteA.Round,
+			dve.VoteA.BlockID.String(),
+			types.ConvertValidatorAddressToBech32String(dve.VoteA.ValidatorAddress),
+			dve.VoteA.ValidatorIndex,
+			hex.EncodeToString(dve.VoteA.Signature),
+		),
+		types.NewDoubleSignVote(
+			int(dve.VoteB.Type),
+			dve.VoteB.Height,
+			dve.VoteB.Round,
+			dve.VoteB.BlockID.String(),
+			types.ConvertValidatorAddressToBech32String(dve.VoteB.ValidatorAddress),
+			dve.VoteB.ValidatorIndex,
+			hex.EncodeToString(dve.VoteB.Signature),
+		),
+	)
+}
+
+// convertLightClientAttackEvidence converts the given tendermint evidence into a LightClientAttackEvidence
+func convertLightClientAttackEvidence(
+	height int64, lcae *tmtypes.LightClientAttackEvidence,
+) types.LightClientAttackEvidence {
+	byzantineValidators := make([]string, len(lcae.ByzantineValidators))
+	for i, validator := range lcae.ByzantineValidators {
+		byzantineValidators[i] = types.ConvertValidatorAddressToBech32String(validator.Address)
+	}
+
+	var conflictingBlockHash string
+	var conflictingBlockHeight int64
+	if lcae.ConflictingBlock != nil && lcae.ConflictingBlock.SignedHeader != nil {
+		conflictingBlockHash = lcae.ConflictingBlock.Hash().String()
+		conflictingBlockHeight = lcae.ConflictingBlock.Height
+	}
+
+	return types.NewLightClientAttackEvidence(
+		fmt.Sprintf("%X", lcae.Hash()),
+		conflictingBlockHash,
+		conflictingBlockHeight,
+		lcae.CommonHeight,
+		byzantineValidators,
+		lcae.TotalVotingPower,
+		lcae.Timestamp,
+		height,
+	)
+}
+
+// parseSlashingEvents returns the validators slash and jail events contained inside the given block events.
+// Jail events that are emitted separately are merged into the slash event of the same validator, if any
+func parseSlashingEvents(height int64, events []abci.Event) []types.ValidatorSlashing {
+	var slashing []types.ValidatorSlashing
+	indexes := map[string]int{}
+
+	// Keep the jailed addresses ordered so that the result is deterministic
+	var jailed []string
+	isJailed := map[string]bool{}
+
+	for _, event := range events {
+		if event.Type != slashingtypes.EventTypeSlash {
+			continue
+		}
+
+		attributes := map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		address, hasAddress := attributes[slashingtypes.AttributeKeyAddress]
+		if !hasAddress {
+			if jailedAddress, ok := attributes[slashingtypes.AttributeKeyJailed]; ok && !isJailed[jailedAddress] {
+				isJailed[jailedAddress] = true
+				jailed = append(jailed, jailedAddress)
+			}
 			continue
 		}
 
-		evidence := types.NewDoubleSignEvidence(
+		infractionHeight, _ := strconv.ParseInt(attributes[slashingtypes.AttributeKeyHeight], 10, 64)
+		slash := types.NewValidatorSlashing(
+			address,
+			infractionHeight,
+			attributes[slashingtypes.AttributeKeyPower],
+			attributes[slashingtypes.AttributeKeyReason],
+			attributes[slashingtypes.AttributeKeyJailed] != "",
+			attributes["burned_coins"],
 			height,
-			types.NewDoubleSignVote(
-				int(dve.VoteA.Type),
-				dve.VoteA.Height,
-				dve.VoteA.Round,
-				dve.VoteA.BlockID.String(),
-				types.ConvertValidatorAddressToBech32String(dve.VoteA.ValidatorAddress),
-				dve.VoteA.ValidatorIndex,
-				hex.EncodeToString(dve.VoteA.Signature),
-			),
-			types.NewDoubleSignVote(
-				int(dve.VoteB.Type),
-				dve.VoteB.Height,
-				dve.VoteB.Round,
-				dve.VoteB.BlockID.String(),
-				types.ConvertValidatorAddressToBech32String(dve.VoteB.ValidatorAddress),
-				dve.VoteB.ValidatorIndex,
-				hex.EncodeToString(dve.VoteB.Signature),
-			),
 		)
 
-		err := m.db.SaveDoubleSignEvidence(evidence)
-		if err != nil {
-			log.Error().Str("module", "staking").Err(err).Int64("height", height).
-				Msg("error while saving double sign evidence")
-			return
+		key := strings.Join([]string{slash.ValidatorAddress, slash.Reason}, "/")
+		if index, ok := indexes[key]; ok {
+			slash.Jailed = slash.Jailed || slashing[index].Jailed
+			slashing[index] = slash
+			continue
 		}
 
+		indexes[key] = len(slashing)
+		slashing = append(slashing, slash)
 	}
+
+	for _, address := range jailed {
+		found := false
+		for i := range slashing {
+			if slashing[i].ValidatorAddress == address {
+				slashing[i].Jailed = true
+				found = true
+			}
+		}
+
+		if !found {
+			slashing = append(slashing, types.NewValidatorSlashing(address, 0, "", types.SlashingReasonJailed, true, "", height))
+		}
+	}
+
+	return slashing
 }
@@ -0,0 +1,124 @@
+package staking
+
+import (
+	"testing"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// newSlashEvent builds a slash event having the given attributes, provided as key/value pairs
+func newSlashEvent(attributes ...string) abci.Event {
+	event := abci.Event{Type: slashingtypes.EventTypeSlash}
+	for i := 0; i < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   []byte(attributes[i]),
+			Value: []byte(attributes[i+1]),
+		})
+	}
+	return event
+}
+
+func TestParseSlashingEvents(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []abci.Event
+		expected []types.ValidatorSlashing
+	}{
+		{
+			name: "non slash events are ignored",
+			events: []abci.Event{
+				{Type: "transfer"},
+			},
+			expected: nil,
+		},
+		{
+			name: "slash event with jailed attribute",
+			events: []abci.Event{
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyPower, "100",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+					slashingtypes.AttributeKeyJailed, "valcons1",
+					slashingtypes.AttributeKeyHeight, "90",
+				),
+			},
+			expected: []types.ValidatorSlashing{
+				types.NewValidatorSlashing("valcons1", 90, "100", slashingtypes.AttributeValueDoubleSign, true, "", testHeight),
+			},
+		},
+		{
+			name: "separate jail event is merged into the slash event",
+			events: []abci.Event{
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyPower, "100",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueMissingSignature,
+					"burned_coins", "10unom",
+				),
+				newSlashEvent(slashingtypes.AttributeKeyJailed, "valcons1"),
+			},
+			expected: []types.ValidatorSlashing{
+				types.NewValidatorSlashing("valcons1", 0, "100", slashingtypes.AttributeValueMissingSignature, true, "10unom", testHeight),
+			},
+		},
+		{
+			name: "jail event without slash event",
+			events: []abci.Event{
+				newSlashEvent(slashingtypes.AttributeKeyJailed, "valcons2"),
+				newSlashEvent(slashingtypes.AttributeKeyJailed, "valcons1"),
+				newSlashEvent(slashingtypes.AttributeKeyJailed, "valcons2"),
+			},
+			expected: []types.ValidatorSlashing{
+				types.NewValidatorSlashing("valcons2", 0, "", types.SlashingReasonJailed, true, "", testHeight),
+				types.NewValidatorSlashing("valcons1", 0, "", types.SlashingReasonJailed, true, "", testHeight),
+			},
+		},
+		{
+			name: "duplicated slash events are merged keeping the jailed flag",
+			events: []abci.Event{
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+					slashingtypes.AttributeKeyJailed, "valcons1",
+				),
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyPower, "50",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+				),
+			},
+			expected: []types.ValidatorSlashing{
+				types.NewValidatorSlashing("valcons1", 0, "50", slashingtypes.AttributeValueDoubleSign, true, "", testHeight),
+			},
+		},
+		{
+			name: "different reasons are kept separately",
+			events: []abci.Event{
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+				),
+				newSlashEvent(
+					slashingtypes.AttributeKeyAddress, "valcons1",
+					slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueMissingSignature,
+				),
+				newSlashEvent(slashingtypes.AttributeKeyJailed, "valcons1"),
+			},
+			expected: []types.ValidatorSlashing{
+				types.NewValidatorSlashing("valcons1", 0, "", slashingtypes.AttributeValueDoubleSign, true, "", testHeight),
+				types.NewValidatorSlashing("valcons1", 0, "", slashingtypes.AttributeValueMissingSignature, true, "", testHeight),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, parseSlashingEvents(testHeight, tc.events))
+		})
+	}
+}
@@ -2,7 +2,6 @@ package staking
 
 import (
 	"fmt"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	staking "github.com/forbole/njuno/modules/staking/utils"
@@ -18,97 +17,128 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 
 	// Fetch updated validators and staking pool info every 5 mins
 	if _, err := scheduler.Every(5).Minutes().Do(func() {
-		utils.WatchMethod(m.updateValidatorsInfo)
+		utils.WatchMethod(m.UpdateValidatorsInfo)
 	}); err != nil {
 		return fmt.Errorf("error while setting up staking period operations: %s", err)
 	}
 
+	// Resolve the validators avatars outside of the validators update
+	if _, err := scheduler.Every(m.avatarCfg.Interval).Do(func() {
+		utils.WatchMethod(m.updateValidatorsAvatars)
+	}); err != nil {
+		return fmt.Errorf("error while setting up validators avatars period operations: %s", err)
+	}
+
 	return nil
 }
 
-// updateValidatorsInfo allows to parse the latest validators infos from yaml file,
-// update staking pool and store it inside the database
-func (m *Module) updateValidatorsInfo() error {
+// UpdateValidatorsInfo allows to parse the latest validators infos read from the validators source,
+// update staking pool and store it inside the database along with the changes history
+func (m *Module) UpdateValidatorsInfo() error {
 	log.Debug().
 		Str("module", "staking").
 		Str("operation", "staking").
 		Msg("updating validators info")
 
-	height, err := m.db.GetLastBlockHeight()
+	block, err := m.db.GetLastBlock()
 	if err != nil {
-		return fmt.Errorf("error while getting latest block height, error: %s", err)
+		return fmt.Errorf("error while getting latest block, error: %s", err)
 	}
+	height := block.Height
 
 	// query the latest validators list
-	validatorsLists := staking.GetLatestValidatorsList()
-
-	// parse validators list
-	validators, validatorsCommission, validatorsDescription, validatorsStatus, validatorsVP := staking.ParseValidatorsList(validatorsLists, height)
-
-	err = m.db.SaveValidators(validators)
+	validatorsLists, err := m.validatorSource.GetValidators()
 	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving validators")
+		return fmt.Errorf("error while getting latest validators list: %s", err)
 	}
 
-	err = m.db.SaveValidatorCommission(validatorsCommission)
+	// read the cached avatars, which are resolved separately by updateValidatorsAvatars
+	avatars, err := m.getCachedAvatars()
 	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving validators commission")
+		return err
 	}
 
-	err = m.db.SaveValidatorDescription(validatorsDescription)
-	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving validators description")
+	// parse validators list
+	validators, validatorsCommission, validatorsDescription, validatorsStatus, validatorsVP := staking.ParseValidatorsList(validatorsLists, avatars, height)
+
+	info := types.ValidatorsInfo{
+		Validators:   validators,
+		Commissions:  validatorsCommission,
+		Descriptions: validatorsDescription,
+		Statuses:     validatorsStatus,
+		VotingPowers: validatorsVP,
+		Timestamp:    block.Timestamp,
 	}
 
-	err = m.db.SaveValidatorsStatus(validatorsStatus)
+	err = m.setValidatorsChanges(&info, height)
 	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving validators status")
+		return fmt.Errorf("error while updating validators history: %s", err)
 	}
 
-	err = m.db.SaveValidatorsVotingPower(validatorsVP)
+	// store the history along with the current values, so that they never get out of sync
+	err = m.db.SaveValidatorsInfo(info)
 	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving validators voting power")
+		return fmt.Errorf("error while saving validators info: %s", err)
 	}
 
 	// update staking pool after updating validators VP
 	// as bonded tokens correspond to overall voting power
-	go m.updateStakingPool(height, validatorsVP)
-
-	return nil
+	return m.updateStakingPool(height, validatorsVP)
 }
 
-// updateStakingPool reads the current staking pool and stores its value inside the database
-func (m *Module) updateStakingPool(height int64, validatorsVP []types.ValidatorVotingPower) {
+// updateStakingPool reads the current staking pool and stores its value inside the database.
+// The pool is read from the node if possible, otherwise it is computed using the given validators voting power
+// as bonded tokens and the total supply as the sum of bonded and not bonded tokens
+func (m *Module) updateStakingPool(height int64, validatorsVP []types.ValidatorVotingPower) error {
 	log.Debug().Str("module", "staking").Int64("height", height).
 		Msg("updating staking pool")
 
-	// Hard code total supply of 21M NOM
-	var totalTokenSupply int64 = 21000000000000
-	var bondedTokens int64
+	totalSupply, err := m.supply.GetTotalSupply()
+	if err != nil {
+		return fmt.Errorf("error while getting total supply: %s", err)
+	}
+	supplyAmount := totalSupply.AmountOf(m.supply.Denom())
 
-	// Calculate the overall voting power as total bonded tokens value
-	for _, vp := range validatorsVP {
-		v, err := strconv.ParseInt(vp.VotingPower, 10, 64)
-		if err != nil {
-			log.Error().Str("module", "staking").Err(err).Int64("height", height).
-				Msg("failed to parse voting power from string to int")
-		}
-		bondedTokens += v
+	bondedTokens, notBondedTokens, err := m.getStakingPoolTokens(supplyAmount, validatorsVP)
+	if err != nil {
+		return fmt.Errorf("error while getting staking pool tokens: %s", err)
 	}
 
-	// Calculate not bonded tokens by subtracting bonded tokens
-	// from the total token supply value
-	var notBondedTokens = totalTokenSupply - bondedTokens
+	bondedRatio := bondedTokens.ToDec().Quo(supplyAmount.ToDec())
+	pool := types.NewStakingPool(bondedTokens, notBondedTokens, bondedRatio, height)
+
+	err = pool.Validate(supplyAmount)
+	if err != nil {
+		return fmt.Errorf("invalid staking pool: %s", err)
+	}
 
-	err := m.db.SaveStakingPool(types.NewStakingPool(sdk.NewInt(bondedTokens), sdk.NewInt(notBondedTokens), height))
+	err = m.db.SaveStakingPool(pool)
 	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving staking pool")
-		return
+		return fmt.Errorf("error while saving staking pool: %s", err)
+	}
+
+	return nil
+}
+
+// getStakingPoolTokens returns the bonded and not bonded tokens read from the node staking pool.
+// If the node does not return a valid pool, the bonded tokens are computed as the overall voting power
+// and the not bonded tokens as the remaining part of the given total supply
+func (m *Module) getStakingPoolTokens(
+	totalSupply sdk.Int, validatorsVP []types.ValidatorVotingPower,
+) (sdk.Int, sdk.Int, error) {
+	pool, err := m.source.StakingPool()
+	if err == nil && !pool.BondedTokens.IsNil() && pool.BondedTokens.IsPositive() {
+		return pool.BondedTokens, pool.NotBondedTokens, nil
 	}
+
+	bondedTokens := sdk.ZeroInt()
+	for _, vp := range validatorsVP {
+		v, ok := sdk.NewIntFromString(vp.VotingPower)
+		if !ok {
+			return sdk.Int{}, sdk.Int{}, fmt.Errorf("invalid voting power of validator %s: %s", vp.SelfDelegateAddress, vp.VotingPower)
+		}
+		bondedTokens = bondedTokens.Add(v)
+	}
+
+	return bondedTokens, totalSupply.Sub(bondedTokens), nil
 }
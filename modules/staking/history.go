@@ -0,0 +1,176 @@
+package staking
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/types"
+)
+
+// setValidatorsChanges compares the validators data contained inside the given info with the one currently stored
+// inside the database, and sets the values that changed along with the resulting validator events.
+// NOTE. This must be called before the current validators data is replaced with the given one.
+func (m *Module) setValidatorsChanges(info *types.ValidatorsInfo, height int64) error {
+	log.Debug().Str("module", "staking").Int64("height", height).Msg("updating validators history")
+
+	storedCommissions, err := m.db.GetValidatorsCommission()
+	if err != nil {
+		return fmt.Errorf("error while getting validators commission: %s", err)
+	}
+
+	storedDescriptions, err := m.db.GetValidatorsDescription()
+	if err != nil {
+		return fmt.Errorf("error while getting validators description: %s", err)
+	}
+
+	storedStatuses, err := m.db.GetValidatorsStatus()
+	if err != nil {
+		return fmt.Errorf("error while getting validators status: %s", err)
+	}
+
+	storedVotingPowers, err := m.db.GetValidatorsVotingPower()
+	if err != nil {
+		return fmt.Errorf("error while getting validators voting power: %s", err)
+	}
+
+	var commissionEvents, statusEvents []types.ValidatorEvent
+	info.ChangedCommissions, commissionEvents = diffCommissions(storedCommissions, info.Commissions, height, info.Timestamp)
+	info.ChangedStatuses, statusEvents = diffStatuses(storedStatuses, info.Statuses, height, info.Timestamp)
+	info.ChangedDescriptions = diffDescriptions(storedDescriptions, info.Descriptions)
+	info.ChangedVotingPowers = diffVotingPowers(storedVotingPowers, info.VotingPowers)
+	info.Events = append(commissionEvents, statusEvents...)
+
+	return nil
+}
+
+// diffCommissions returns the commissions that changed with respect to the stored ones,
+// along with the commission_changed events
+func diffCommissions(
+	stored, current []types.ValidatorCommission, height int64, timestamp time.Time,
+) ([]types.ValidatorCommission, []types.ValidatorEvent) {
+	storedMap := make(map[string]types.ValidatorCommission, len(stored))
+	for _, commission := range stored {
+		storedMap[commission.SelfDelegateAddress] = commission
+	}
+
+	var changed []types.ValidatorCommission
+	var events []types.ValidatorEvent
+	for _, commission := range current {
+		old, found := storedMap[commission.SelfDelegateAddress]
+		if found && old.Commission == commission.Commission && old.MinSelfDelegation == commission.MinSelfDelegation {
+			continue
+		}
+
+		changed = append(changed, commission)
+		if found && old.Commission != commission.Commission {
+			events = append(events, types.NewValidatorEvent(
+				commission.SelfDelegateAddress, commission.ValAddress, types.ValidatorEventCommissionChanged,
+				old.Commission, commission.Commission, height, timestamp,
+			))
+		}
+	}
+
+	return changed, events
+}
+
+// diffDescriptions returns the descriptions that changed with respect to the stored ones
+func diffDescriptions(stored, current []types.ValidatorDescription) []types.ValidatorDescription {
+	storedMap := make(map[string]types.ValidatorDescription, len(stored))
+	for _, description := range stored {
+		storedMap[description.SelfDelegateAddress] = description
+	}
+
+	var changed []types.ValidatorDescription
+	for _, description := range current {
+		old, found := storedMap[description.SelfDelegateAddress]
+		if found &&
+			old.Moniker == description.Moniker &&
+			old.Identity == description.Identity &&
+			old.AvatarURL == description.AvatarURL &&
+			old.Description == description.Description {
+			continue
+		}
+
+		changed = append(changed, description)
+	}
+
+	return changed
+}
+
+// diffStatuses returns the statuses that changed with respect to the stored ones,
+// along with the jailed, unjailed, tombstoned, entered_active_set and left_active_set events
+func diffStatuses(
+	stored, current []types.ValidatorStatus, height int64, timestamp time.Time,
+) ([]types.ValidatorStatus, []types.ValidatorEvent) {
+	storedMap := make(map[string]types.ValidatorStatus, len(stored))
+	for _, status := range stored {
+		storedMap[status.SelfDelegateAddress] = status
+	}
+
+	var changed []types.ValidatorStatus
+	var events []types.ValidatorEvent
+	for _, status := range current {
+		old, found := storedMap[status.SelfDelegateAddress]
+		if found &&
+			old.InActiveSet == status.InActiveSet &&
+			old.Jailed == status.Jailed &&
+			old.Tombstoned == status.Tombstoned {
+			continue
+		}
+
+		changed = append(changed, status)
+		if !found {
+			continue
+		}
+
+		newEvent := func(eventType types.ValidatorEventType, oldValue, newValue string) types.ValidatorEvent {
+			return types.NewValidatorEvent(
+				status.SelfDelegateAddress, status.ConsensusAddress, eventType, oldValue, newValue, height, timestamp,
+			)
+		}
+
+		if old.Jailed != status.Jailed {
+			eventType := types.ValidatorEventUnjailed
+			if status.Jailed == "true" {
+				eventType = types.ValidatorEventJailed
+			}
+			events = append(events, newEvent(eventType, old.Jailed, status.Jailed))
+		}
+
+		if old.Tombstoned != status.Tombstoned && status.Tombstoned == "true" {
+			events = append(events, newEvent(types.ValidatorEventTombstoned, old.Tombstoned, status.Tombstoned))
+		}
+
+		if old.InActiveSet != status.InActiveSet {
+			eventType := types.ValidatorEventLeftActiveSet
+			if status.InActiveSet == "true" {
+				eventType = types.ValidatorEventEnteredActiveSet
+			}
+			events = append(events, newEvent(eventType, old.InActiveSet, status.InActiveSet))
+		}
+	}
+
+	return changed, events
+}
+
+// diffVotingPowers returns the voting powers that changed with respect to the stored ones
+func diffVotingPowers(stored, current []types.ValidatorVotingPower) []types.ValidatorVotingPower {
+	storedMap := make(map[string]string, len(stored))
+	for _, votingPower := range stored {
+		storedMap[votingPower.SelfDelegateAddress] = votingPower.VotingPower
+	}
+
+	var changed []types.ValidatorVotingPower
+	for _, votingPower := range current {
+		old, found := storedMap[votingPower.SelfDelegateAddress]
+		if found && old == votingPower.VotingPower {
+			continue
+		}
+
+		changed = append(changed, votingPower)
+	}
+
+	return changed
+}
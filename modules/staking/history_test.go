@@ -0,0 +1,247 @@
+package staking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	testHeight    = int64(100)
+	testTimestamp = time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestDiffCommissions(t *testing.T) {
+	stored := []types.ValidatorCommission{
+		types.NewValidatorCommission("val1", "self1", "0.05", "1", 10),
+		types.NewValidatorCommission("val2", "self2", "0.10", "1", 10),
+	}
+
+	testCases := []struct {
+		name            string
+		current         []types.ValidatorCommission
+		expectedChanged []types.ValidatorCommission
+		expectedEvents  []types.ValidatorEvent
+	}{
+		{
+			name: "unchanged commissions",
+			current: []types.ValidatorCommission{
+				types.NewValidatorCommission("val1", "self1", "0.05", "1", testHeight),
+			},
+		},
+		{
+			name: "changed commission rate",
+			current: []types.ValidatorCommission{
+				types.NewValidatorCommission("val1", "self1", "0.07", "1", testHeight),
+				types.NewValidatorCommission("val2", "self2", "0.10", "1", testHeight),
+			},
+			expectedChanged: []types.ValidatorCommission{
+				types.NewValidatorCommission("val1", "self1", "0.07", "1", testHeight),
+			},
+			expectedEvents: []types.ValidatorEvent{
+				types.NewValidatorEvent("self1", "val1", types.ValidatorEventCommissionChanged, "0.05", "0.07", testHeight, testTimestamp),
+			},
+		},
+		{
+			name: "changed min self delegation only",
+			current: []types.ValidatorCommission{
+				types.NewValidatorCommission("val2", "self2", "0.10", "5", testHeight),
+			},
+			expectedChanged: []types.ValidatorCommission{
+				types.NewValidatorCommission("val2", "self2", "0.10", "5", testHeight),
+			},
+		},
+		{
+			name: "new validator",
+			current: []types.ValidatorCommission{
+				types.NewValidatorCommission("val3", "self3", "0.20", "1", testHeight),
+			},
+			expectedChanged: []types.ValidatorCommission{
+				types.NewValidatorCommission("val3", "self3", "0.20", "1", testHeight),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, events := diffCommissions(stored, tc.current, testHeight, testTimestamp)
+			require.Equal(t, tc.expectedChanged, changed)
+			require.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestDiffDescriptions(t *testing.T) {
+	stored := []types.ValidatorDescription{
+		types.NewValidatorDescription("val1", "self1", "details", "identity", "https://avatar", "moniker", 10),
+	}
+
+	testCases := []struct {
+		name     string
+		current  []types.ValidatorDescription
+		expected []types.ValidatorDescription
+	}{
+		{
+			name: "unchanged description with a different height",
+			current: []types.ValidatorDescription{
+				types.NewValidatorDescription("val1", "self1", "details", "identity", "https://avatar", "moniker", testHeight),
+			},
+		},
+		{
+			name: "changed moniker",
+			current: []types.ValidatorDescription{
+				types.NewValidatorDescription("val1", "self1", "details", "identity", "https://avatar", "new moniker", testHeight),
+			},
+			expected: []types.ValidatorDescription{
+				types.NewValidatorDescription("val1", "self1", "details", "identity", "https://avatar", "new moniker", testHeight),
+			},
+		},
+		{
+			name: "changed avatar",
+			current: []types.ValidatorDescription{
+				types.NewValidatorDescription("val1", "self1", "details", "identity", "https://new-avatar", "moniker", testHeight),
+			},
+			expected: []types.ValidatorDescription{
+				types.NewValidatorDescription("val1", "self1", "details", "identity", "https://new-avatar", "moniker", testHeight),
+			},
+		},
+		{
+			name: "new validator",
+			current: []types.ValidatorDescription{
+				types.NewValidatorDescription("val2", "self2", "", "", "", "other", testHeight),
+			},
+			expected: []types.ValidatorDescription{
+				types.NewValidatorDescription("val2", "self2", "", "", "", "other", testHeight),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffDescriptions(stored, tc.current))
+		})
+	}
+}
+
+func TestDiffStatuses(t *testing.T) {
+	stored := []types.ValidatorStatus{
+		types.NewValidatorStatus("cons1", "self1", "true", "false", "false", 10),
+		types.NewValidatorStatus("cons2", "self2", "false", "true", "false", 10),
+	}
+
+	newEvent := func(selfDelegateAddress, consAddress string, eventType types.ValidatorEventType, oldValue, newValue string) types.ValidatorEvent {
+		return types.NewValidatorEvent(selfDelegateAddress, consAddress, eventType, oldValue, newValue, testHeight, testTimestamp)
+	}
+
+	testCases := []struct {
+		name            string
+		current         []types.ValidatorStatus
+		expectedChanged []types.ValidatorStatus
+		expectedEvents  []types.ValidatorEvent
+	}{
+		{
+			name: "unchanged statuses",
+			current: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons1", "self1", "true", "false", "false", testHeight),
+				types.NewValidatorStatus("cons2", "self2", "false", "true", "false", testHeight),
+			},
+		},
+		{
+			name: "jailed and left the active set",
+			current: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons1", "self1", "false", "true", "false", testHeight),
+			},
+			expectedChanged: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons1", "self1", "false", "true", "false", testHeight),
+			},
+			expectedEvents: []types.ValidatorEvent{
+				newEvent("self1", "cons1", types.ValidatorEventJailed, "false", "true"),
+				newEvent("self1", "cons1", types.ValidatorEventLeftActiveSet, "true", "false"),
+			},
+		},
+		{
+			name: "unjailed and entered the active set",
+			current: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons2", "self2", "true", "false", "false", testHeight),
+			},
+			expectedChanged: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons2", "self2", "true", "false", "false", testHeight),
+			},
+			expectedEvents: []types.ValidatorEvent{
+				newEvent("self2", "cons2", types.ValidatorEventUnjailed, "true", "false"),
+				newEvent("self2", "cons2", types.ValidatorEventEnteredActiveSet, "false", "true"),
+			},
+		},
+		{
+			name: "tombstoned",
+			current: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons2", "self2", "false", "true", "true", testHeight),
+			},
+			expectedChanged: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons2", "self2", "false", "true", "true", testHeight),
+			},
+			expectedEvents: []types.ValidatorEvent{
+				newEvent("self2", "cons2", types.ValidatorEventTombstoned, "false", "true"),
+			},
+		},
+		{
+			name: "new validator without events",
+			current: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons3", "self3", "true", "false", "false", testHeight),
+			},
+			expectedChanged: []types.ValidatorStatus{
+				types.NewValidatorStatus("cons3", "self3", "true", "false", "false", testHeight),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, events := diffStatuses(stored, tc.current, testHeight, testTimestamp)
+			require.Equal(t, tc.expectedChanged, changed)
+			require.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestDiffVotingPowers(t *testing.T) {
+	stored := []types.ValidatorVotingPower{
+		types.NewValidatorVotingPower("cons1", "self1", "100", 10),
+		types.NewValidatorVotingPower("cons2", "self2", "200", 10),
+	}
+
+	testCases := []struct {
+		name     string
+		current  []types.ValidatorVotingPower
+		expected []types.ValidatorVotingPower
+	}{
+		{
+			name: "unchanged voting powers",
+			current: []types.ValidatorVotingPower{
+				types.NewValidatorVotingPower("cons1", "self1", "100", testHeight),
+				types.NewValidatorVotingPower("cons2", "self2", "200", testHeight),
+			},
+		},
+		{
+			name: "changed and new voting powers",
+			current: []types.ValidatorVotingPower{
+				types.NewValidatorVotingPower("cons1", "self1", "150", testHeight),
+				types.NewValidatorVotingPower("cons2", "self2", "200", testHeight),
+				types.NewValidatorVotingPower("cons3", "self3", "50", testHeight),
+			},
+			expected: []types.ValidatorVotingPower{
+				types.NewValidatorVotingPower("cons1", "self1", "150", testHeight),
+				types.NewValidatorVotingPower("cons3", "self3", "50", testHeight),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffVotingPowers(stored, tc.current))
+		})
+	}
+}
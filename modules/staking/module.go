@@ -6,7 +6,10 @@ import (
 	"github.com/forbole/njuno/database"
 	"github.com/forbole/njuno/logging"
 	"github.com/forbole/njuno/modules"
-	source "github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/modules/bank/supply"
+	"github.com/forbole/njuno/modules/staking/avatar"
+	"github.com/forbole/njuno/modules/staking/source"
+	"github.com/forbole/njuno/node"
 	"github.com/forbole/njuno/types/config"
 )
 
@@ -18,20 +21,41 @@ var (
 
 // Module represents the staking module
 type Module struct {
-	cfg    config.Config
-	cdc    codec.Marshaler
-	db     database.Database
-	logger logging.Logger
-	source source.Node
+	cfg             config.Config
+	cdc             codec.Marshaler
+	db              database.Database
+	logger          logging.Logger
+	source          node.Node
+	validatorSource source.ValidatorSource
+	supply          *supply.Provider
+	avatarProvider  avatar.AvatarProvider
+	avatarCfg       *avatar.Config
 }
 
-func NewModule(cfg config.Config, cdc codec.Marshaler, db database.Database, logger logging.Logger, source source.Node) *Module {
+func NewModule(
+	cfg config.Config, cdc codec.Marshaler, db database.Database, logger logging.Logger, node node.Node,
+	supplyProvider *supply.Provider,
+) *Module {
+	validatorSource, err := NewValidatorSource(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	avatarProvider, avatarCfg, err := NewAvatarProvider(cfg)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		cfg:    cfg,
-		cdc:    cdc,
-		db:     db,
-		logger: logger,
-		source: source,
+		cfg:             cfg,
+		cdc:             cdc,
+		db:              db,
+		logger:          logger,
+		source:          node,
+		validatorSource: validatorSource,
+		supply:          supplyProvider,
+		avatarProvider:  avatarProvider,
+		avatarCfg:       avatarCfg,
 	}
 }
 
@@ -0,0 +1,53 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ ValidatorSource = &CLISource{}
+)
+
+// CLISource represents a ValidatorSource that reads the validators list from the output of a CLI command
+type CLISource struct {
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+// NewCLISource builds a new CLISource instance
+func NewCLISource(command string, args []string, timeout time.Duration) *CLISource {
+	return &CLISource{
+		command: command,
+		args:    args,
+		timeout: timeout,
+	}
+}
+
+// GetValidators implements ValidatorSource
+func (s *CLISource) GetValidators() (*types.ValidatorsList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("timeout while running %s after %s", s.command, s.timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while running %s: %s: %s", s.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseValidatorsList(stdout.Bytes())
+}
@@ -0,0 +1,34 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ ValidatorSource = &FileSource{}
+)
+
+// FileSource represents a ValidatorSource that reads the validators list from a static YAML or JSON file
+type FileSource struct {
+	path string
+}
+
+// NewFileSource builds a new FileSource instance
+func NewFileSource(path string) *FileSource {
+	return &FileSource{
+		path: path,
+	}
+}
+
+// GetValidators implements ValidatorSource
+func (s *FileSource) GetValidators() (*types.ValidatorsList, error) {
+	bz, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading validators list file: %s", err)
+	}
+
+	return parseValidatorsList(bz)
+}
@@ -0,0 +1,48 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+var (
+	_ ValidatorSource = &HTTPSource{}
+)
+
+// HTTPSource represents a ValidatorSource that reads the validators list from an HTTP endpoint
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSource builds a new HTTPSource instance
+func NewHTTPSource(url string, timeout time.Duration) *HTTPSource {
+	return &HTTPSource{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// GetValidators implements ValidatorSource
+func (s *HTTPSource) GetValidators() (*types.ValidatorsList, error) {
+	resp, err := s.client.Get(s.url)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying validators endpoint: %s", err)
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading validators response body: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("validators endpoint returned status %d: %s", resp.StatusCode, bz)
+	}
+
+	return parseValidatorsList(bz)
+}
@@ -0,0 +1,95 @@
+package source
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/forbole/njuno/types"
+)
+
+const (
+	TypeCLI  = "cli"
+	TypeHTTP = "http"
+	TypeFile = "file"
+)
+
+// ValidatorSource represents a source from which the latest validators list can be read
+type ValidatorSource interface {
+	// GetValidators returns the latest validators list.
+	// An error is returned if the list cannot be read or if it does not contain any validator.
+	GetValidators() (*types.ValidatorsList, error)
+}
+
+// Config contains the configuration of the source used to read the validators list
+type Config struct {
+	Type     string        `yaml:"type"`
+	Command  string        `yaml:"command,omitempty"`
+	Args     []string      `yaml:"args,omitempty"`
+	URL      string        `yaml:"url,omitempty"`
+	FilePath string        `yaml:"file_path,omitempty"`
+	Timeout  time.Duration `yaml:"timeout,omitempty"`
+}
+
+// DefaultConfig returns the default source configuration, which reads the validators using the nomic CLI
+func DefaultConfig() *Config {
+	return &Config{
+		Type:    TypeCLI,
+		Command: "nomic",
+		Args:    []string{"validators"},
+		Timeout: 30 * time.Second,
+	}
+}
+
+// NewValidatorSource builds the ValidatorSource instance described by the given configuration
+func NewValidatorSource(cfg *Config) (ValidatorSource, error) {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	switch cfg.Type {
+	case TypeCLI:
+		if cfg.Command == "" {
+			return nil, fmt.Errorf("missing command of the validators cli source")
+		}
+		return NewCLISource(cfg.Command, cfg.Args, timeout), nil
+
+	case TypeHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("missing url of the validators http source")
+		}
+		return NewHTTPSource(cfg.URL, timeout), nil
+
+	case TypeFile:
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("missing file path of the validators file source")
+		}
+		return NewFileSource(cfg.FilePath), nil
+
+	default:
+		return nil, fmt.Errorf("invalid validators source type: %s", cfg.Type)
+	}
+}
+
+// parseValidatorsList parses the given YAML (or JSON) validators list.
+// Both a list wrapped inside the validators field and the bare list printed by the nomic CLI are supported.
+func parseValidatorsList(bz []byte) (*types.ValidatorsList, error) {
+	var validatorsList types.ValidatorsList
+	err := yaml.Unmarshal(bz, &validatorsList)
+	if err != nil {
+		// Try parsing the bare list
+		var validators []types.ValidatorList
+		if yaml.Unmarshal(bz, &validators) != nil {
+			return nil, fmt.Errorf("error while unmarshaling validators list: %s", err)
+		}
+		validatorsList.Validators = validators
+	}
+
+	if len(validatorsList.Validators) == 0 {
+		return nil, fmt.Errorf("no validators found inside the validators list")
+	}
+
+	return &validatorsList, nil
+}
@@ -0,0 +1,107 @@
+package source_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/staking/source"
+	"github.com/forbole/njuno/types"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// requireGolden checks that the given validators list matches the content of the golden file
+func requireGolden(t *testing.T, validators *types.ValidatorsList) {
+	bz, err := json.MarshalIndent(validators, "", "  ")
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "validators.golden")
+	if *update {
+		require.NoError(t, ioutil.WriteFile(golden, bz, 0644))
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(bz))
+}
+
+func TestCLISource_GetValidators(t *testing.T) {
+	src := source.NewCLISource("cat", []string{filepath.Join("testdata", "nomic_validators.txt")}, time.Second)
+	validators, err := src.GetValidators()
+	require.NoError(t, err)
+	requireGolden(t, validators)
+
+	src = source.NewCLISource("sleep", []string{"5"}, 100*time.Millisecond)
+	_, err = src.GetValidators()
+	require.Error(t, err)
+
+	src = source.NewCLISource("false", nil, time.Second)
+	_, err = src.GetValidators()
+	require.Error(t, err)
+
+	src = source.NewCLISource("true", nil, time.Second)
+	_, err = src.GetValidators()
+	require.Error(t, err)
+}
+
+func TestHTTPSource_GetValidators(t *testing.T) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "validators.json"))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/validators" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(bz)
+	}))
+	defer server.Close()
+
+	src := source.NewHTTPSource(server.URL+"/validators", time.Second)
+	validators, err := src.GetValidators()
+	require.NoError(t, err)
+	requireGolden(t, validators)
+
+	src = source.NewHTTPSource(server.URL+"/invalid", time.Second)
+	_, err = src.GetValidators()
+	require.Error(t, err)
+}
+
+func TestFileSource_GetValidators(t *testing.T) {
+	src := source.NewFileSource(filepath.Join("testdata", "validators.yaml"))
+	validators, err := src.GetValidators()
+	require.NoError(t, err)
+	requireGolden(t, validators)
+
+	src = source.NewFileSource(filepath.Join("testdata", "non_existing.yaml"))
+	_, err = src.GetValidators()
+	require.Error(t, err)
+}
+
+func TestNewValidatorSource(t *testing.T) {
+	src, err := source.NewValidatorSource(source.DefaultConfig())
+	require.NoError(t, err)
+	require.IsType(t, &source.CLISource{}, src)
+
+	src, err = source.NewValidatorSource(&source.Config{Type: source.TypeHTTP, URL: "http://localhost"})
+	require.NoError(t, err)
+	require.IsType(t, &source.HTTPSource{}, src)
+
+	src, err = source.NewValidatorSource(&source.Config{Type: source.TypeFile, FilePath: "validators.yaml"})
+	require.NoError(t, err)
+	require.IsType(t, &source.FileSource{}, src)
+
+	_, err = source.NewValidatorSource(&source.Config{Type: source.TypeFile})
+	require.Error(t, err)
+
+	_, err = source.NewValidatorSource(&source.Config{Type: "invalid"})
+	require.Error(t, err)
+}
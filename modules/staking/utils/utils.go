@@ -1,50 +1,14 @@
 package utils
 
 import (
-	"bytes"
-	"fmt"
-	"io/ioutil"
-	"os"
-	"os/exec"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/forbole/njuno/modules/staking/keybase"
 	types "github.com/forbole/njuno/types"
-	"github.com/forbole/njuno/types/config"
-	"github.com/rs/zerolog/log"
-	"gopkg.in/yaml.v2"
 )
 
-// GetLatestValidatorsList queries the latest validators list, stores it inside yaml file,
-// and returns an array of validators
-func GetLatestValidatorsList() *types.ValidatorsList {
-	validatorsCmd := exec.Command("sh", "-c", "$HOME/.njuno/validators_query.sh")
-
-	cmdOutput := &bytes.Buffer{}
-	validatorsCmd.Stdout = cmdOutput
-	validatorsCmd.Stderr = os.Stderr
-	if err := validatorsCmd.Run(); err != nil {
-		return nil
-	}
-
-	// Get the validators list
-	validatorsList := &types.ValidatorsList{}
-	yamlFile, err := ioutil.ReadFile(config.Cfg.Parser.ValidatorsListFilePath)
-	if err != nil {
-		log.Printf("error while reading validators list yaml file: %s ", err)
-	}
-
-	err = yaml.Unmarshal(yamlFile, validatorsList)
-	if err != nil {
-		log.Printf("error while unmarshaling validators list yaml file: %s ", err)
-	}
-
-	return validatorsList
-}
-
 // ParseValidatorsList parses the validators list and returns arrays of validators,
-// validators description, validators commission and validators status
-func ParseValidatorsList(validatorsList *types.ValidatorsList, height int64) ([]types.Validator, []types.ValidatorCommission, []types.ValidatorDescription, []types.ValidatorStatus, []types.ValidatorVotingPower) {
+// validators description, validators commission and validators status.
+// The avatar of each validator is read from the given identity to avatar URL mapping.
+func ParseValidatorsList(validatorsList *types.ValidatorsList, avatars map[string]string, height int64) ([]types.Validator, []types.ValidatorCommission, []types.ValidatorDescription, []types.ValidatorStatus, []types.ValidatorVotingPower) {
 	var validators []types.Validator
 	var validatorsCommission []types.ValidatorCommission
 	var validatorsDescription []types.ValidatorDescription
@@ -53,10 +17,7 @@ func ParseValidatorsList(validatorsList *types.ValidatorsList, height int64) ([]
 
 	for _, val := range validatorsList.Validators {
 		consAddr := sdk.ConsAddress(val.Validator.Address)
-		avatarURL, err := keybase.GetAvatarURL(val.Validator.Identity)
-		if err != nil {
-			fmt.Printf("error while getting Avatar URL: %s", err)
-		}
+		avatarURL := avatars[val.Validator.Identity]
 
 		validators = append(validators, types.NewValidator(consAddr.String(), val.Validator.Address, height))
 		validatorsCommission = append(validatorsCommission, types.NewValidatorCommission(consAddr.String(), val.Validator.Address, val.Validator.Commission, val.Validator.MinSelfDelegation, height))
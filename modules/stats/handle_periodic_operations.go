@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
+)
+
+// statsChunkPeriods represents the number of periods that are aggregated using a single query
+const statsChunkPeriods = 24
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "stats").Msg("setting up periodic tasks")
+
+	// Update the hourly stats every 5 mins
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(func() error { return m.updateStats(types.StatsGranularityHour) })
+	}); err != nil {
+		return fmt.Errorf("error while setting up stats periodic operations: %s", err)
+	}
+
+	// Update the daily stats every 30 mins
+	if _, err := scheduler.Every(30).Minutes().Do(func() {
+		utils.WatchMethod(func() error { return m.updateStats(types.StatsGranularityDay) })
+	}); err != nil {
+		return fmt.Errorf("error while setting up stats periodic operations: %s", err)
+	}
+
+	return nil
+}
+
+// updateStats incrementally updates the chain statistics having the given granularity,
+// starting from the latest stored period up to the current one
+func (m *Module) updateStats(granularity types.StatsGranularity) error {
+	log.Debug().Str("module", "stats").Str("granularity", string(granularity)).
+		Msg("updating chain stats")
+
+	from, err := m.db.GetLastChainStatsPeriod(granularity)
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks stored yet
+	if from.IsZero() {
+		return nil
+	}
+
+	return m.UpdateStatsInRange(granularity, from, time.Now())
+}
+
+// UpdateStatsInRange computes and stores the chain statistics having the given granularity
+// for all the periods between the one containing from and the one containing to (both included).
+// The range is processed in chunks so that each query only scans a limited amount of blocks.
+func (m *Module) UpdateStatsInRange(granularity types.StatsGranularity, from, to time.Time) error {
+	return utils.ForEachPeriodsChunk(granularity, from, to, statsChunkPeriods,
+		func(start, end time.Time) error {
+			log.Trace().Str("module", "stats").Str("granularity", string(granularity)).
+				Time("from", start).Time("to", end).Msg("updating chain stats range")
+			return m.db.UpdateChainStats(granularity, start, end)
+		},
+	)
+}
@@ -0,0 +1,31 @@
+package uptime
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration of the uptime module
+type Config struct {
+	WindowSize int64 `yaml:"window_size"`
+}
+
+// NewConfig allows to build a new Config instance
+func NewConfig(windowSize int64) *Config {
+	return &Config{
+		WindowSize: windowSize,
+	}
+}
+
+// DefaultConfig returns the default uptime configuration
+func DefaultConfig() *Config {
+	return NewConfig(10000)
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"uptime"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
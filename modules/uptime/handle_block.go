@@ -0,0 +1,56 @@
+package uptime
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ *tmctypes.ResultValidators,
+) error {
+	commit := block.Block.LastCommit
+	if commit == nil || commit.Height <= 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "uptime").Int64("height", commit.Height).Msg("updating validators uptime")
+
+	// The commit contained inside the block is signed by the validator set of the previous height,
+	// which is not the one passed along with the block
+	vals, err := m.node.Validators(commit.Height)
+	if err != nil {
+		return fmt.Errorf("error while getting validators of height %d: %s", commit.Height, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.db.SaveValidatorsSignatures(getValidatorsSignatures(commit, vals), m.cfg.WindowSize)
+}
+
+// getValidatorsSignatures returns whether each validator of the given set, which must be the one of the
+// commit height, signed the given commit
+func getValidatorsSignatures(commit *tmtypes.Commit, vals *tmctypes.ResultValidators) []types.ValidatorSignature {
+	signers := make(map[string]bool, len(commit.Signatures))
+	for _, commitSig := range commit.Signatures {
+		// Avoid empty commits
+		if commitSig.Signature == nil {
+			continue
+		}
+		signers[types.ConvertValidatorAddressToBech32String(commitSig.ValidatorAddress)] = true
+	}
+
+	signatures := make([]types.ValidatorSignature, len(vals.Validators))
+	for i, val := range vals.Validators {
+		address := types.ConvertValidatorAddressToBech32String(val.Address)
+		signatures[i] = types.NewValidatorSignature(address, commit.Height, signers[address])
+	}
+
+	return signatures
+}
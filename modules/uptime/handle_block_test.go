@@ -0,0 +1,38 @@
+package uptime
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+func TestGetValidatorsSignatures(t *testing.T) {
+	addr1 := bytes.Repeat([]byte{1}, 20)
+	addr2 := bytes.Repeat([]byte{2}, 20)
+
+	// Validator 2 was part of the set of the commit height and did not sign, so its signature is absent
+	commit := &tmtypes.Commit{
+		Height: 9,
+		Signatures: []tmtypes.CommitSig{
+			{BlockIDFlag: tmtypes.BlockIDFlagCommit, ValidatorAddress: addr1, Signature: []byte("signature")},
+			{BlockIDFlag: tmtypes.BlockIDFlagAbsent},
+		},
+	}
+	vals := &tmctypes.ResultValidators{
+		BlockHeight: 9,
+		Validators: []*tmtypes.Validator{
+			{Address: addr1, VotingPower: 10},
+			{Address: addr2, VotingPower: 10},
+		},
+	}
+
+	require.Equal(t, []types.ValidatorSignature{
+		types.NewValidatorSignature(types.ConvertValidatorAddressToBech32String(addr1), 9, true),
+		types.NewValidatorSignature(types.ConvertValidatorAddressToBech32String(addr2), 9, false),
+	}, getValidatorsSignatures(commit, vals))
+}
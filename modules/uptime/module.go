@@ -0,0 +1,53 @@
+package uptime
+
+import (
+	"sync"
+
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module      = &Module{}
+	_ modules.BlockModule = &Module{}
+)
+
+// Module represents the module that computes the validators uptime from the commit signatures
+type Module struct {
+	cfg  *Config
+	db   database.Database
+	node node.Node
+
+	// mu makes sure that the signing window is updated by a single worker at a time
+	mu sync.Mutex
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db database.Database, node node.Node) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	uptimeCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if uptimeCfg == nil || uptimeCfg.WindowSize <= 0 {
+		uptimeCfg = DefaultConfig()
+	}
+
+	return &Module{
+		cfg:  uptimeCfg,
+		db:   db,
+		node: node,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "uptime"
+}
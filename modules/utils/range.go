@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+// ForEachPeriodsChunk splits the periods having the given granularity between the one containing from and
+// the one containing to (both included) into chunks of at most chunkPeriods periods each,
+// and calls the given function with the [start, end) bounds of each chunk.
+// The iteration stops at the first error returned by the function.
+func ForEachPeriodsChunk(
+	granularity types.StatsGranularity, from, to time.Time, chunkPeriods int64, fn func(start, end time.Time) error,
+) error {
+	start := granularity.Truncate(from)
+	end := granularity.Truncate(to).Add(granularity.Duration())
+	chunk := time.Duration(chunkPeriods) * granularity.Duration()
+
+	for ; start.Before(end); start = start.Add(chunk) {
+		chunkEnd := start.Add(chunk)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+
+		err := fn(start, chunkEnd)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package utils_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
+)
+
+func TestForEachPeriodsChunk(t *testing.T) {
+	from := time.Date(2022, 11, 1, 10, 30, 0, 0, time.UTC)
+	to := time.Date(2022, 11, 1, 14, 10, 0, 0, time.UTC)
+
+	var chunks [][2]time.Time
+	err := utils.ForEachPeriodsChunk(types.StatsGranularityHour, from, to, 2, func(start, end time.Time) error {
+		chunks = append(chunks, [2]time.Time{start, end})
+		return nil
+	})
+	require.NoError(t, err)
+
+	hour := func(h int) time.Time { return time.Date(2022, 11, 1, h, 0, 0, 0, time.UTC) }
+	require.Equal(t, [][2]time.Time{
+		{hour(10), hour(12)},
+		{hour(12), hour(14)},
+		{hour(14), hour(15)},
+	}, chunks)
+
+	calls := 0
+	err = utils.ForEachPeriodsChunk(types.StatsGranularityHour, from, to, 2, func(start, end time.Time) error {
+		calls++
+		return fmt.Errorf("error")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,52 @@
+package validatorset
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ModeAll stores the whole validator set of each height.
+	// This is required to compute the expected proposals of the proposer statistics.
+	ModeAll = "all"
+
+	// ModeDiff stores only the validators whose voting power changed, joined or left the set
+	// compared to the previous height. The whole set is stored at the lowest parsed height as a baseline.
+	// This mode cannot be used together with the proposer module
+	ModeDiff = "diff"
+)
+
+// Config contains the configuration of the validator set module
+type Config struct {
+	Mode string `yaml:"mode"`
+}
+
+// NewConfig allows to build a new Config instance
+func NewConfig(mode string) *Config {
+	return &Config{
+		Mode: mode,
+	}
+}
+
+// DefaultConfig returns the default validator set configuration
+func DefaultConfig() *Config {
+	return NewConfig(ModeAll)
+}
+
+// Validate checks whether the configuration is valid
+func (c *Config) Validate() error {
+	if c.Mode != ModeAll && c.Mode != ModeDiff {
+		return fmt.Errorf("invalid validator set mode: %s", c.Mode)
+	}
+	return nil
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"validator_set"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -0,0 +1,94 @@
+package validatorset
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/njuno/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, vals *tmctypes.ResultValidators,
+) error {
+	height := block.Block.Height
+	log.Debug().Str("module", "validator_set").Int64("height", height).Msg("updating validator set")
+
+	entries := convertValidatorSet(vals, height)
+	if m.cfg.Mode == ModeDiff {
+		// Store the whole set when no lower height has been stored yet (e.g. the first parsed height),
+		// so that the following diffs always have a baseline to be applied to
+		hasBaseline, err := m.db.HasValidatorSetBefore(height)
+		if err != nil {
+			return err
+		}
+
+		if hasBaseline {
+			previous, err := m.getPreviousValidatorSet(height)
+			if err != nil {
+				return err
+			}
+			entries = diffValidatorSet(previous, entries, height)
+		}
+	}
+
+	return m.db.SaveValidatorSet(entries)
+}
+
+// getPreviousValidatorSet returns the validator set of the height before the given one, as it is reported by the node.
+// The set is read from the node rather than from the database so that the diff does not depend on the order
+// in which the heights are parsed.
+func (m *Module) getPreviousValidatorSet(height int64) ([]types.ValidatorSetEntry, error) {
+	if height <= 1 {
+		return nil, nil
+	}
+
+	vals, err := m.node.Validators(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validator set at height %d: %s", height-1, err)
+	}
+
+	return convertValidatorSet(vals, height-1), nil
+}
+
+// convertValidatorSet converts the given Tendermint validators into the entries of the given height
+func convertValidatorSet(vals *tmctypes.ResultValidators, height int64) []types.ValidatorSetEntry {
+	entries := make([]types.ValidatorSetEntry, len(vals.Validators))
+	for i, val := range vals.Validators {
+		entries[i] = types.NewValidatorSetEntry(
+			types.ConvertValidatorAddressToBech32String(val.Address), val.VotingPower, val.ProposerPriority, height,
+		)
+	}
+	return entries
+}
+
+// diffValidatorSet returns the entries of the current set whose voting power is different from the previous one,
+// along with a zero voting power entry for each validator that left the set.
+// Since the proposer priority changes with every block, it is not taken into account.
+func diffValidatorSet(previous, current []types.ValidatorSetEntry, height int64) []types.ValidatorSetEntry {
+	previousPower := make(map[string]int64, len(previous))
+	for _, entry := range previous {
+		previousPower[entry.ValidatorAddress] = entry.VotingPower
+	}
+
+	var changed []types.ValidatorSetEntry
+	currentSet := make(map[string]bool, len(current))
+	for _, entry := range current {
+		currentSet[entry.ValidatorAddress] = true
+
+		power, found := previousPower[entry.ValidatorAddress]
+		if !found || power != entry.VotingPower {
+			changed = append(changed, entry)
+		}
+	}
+
+	for _, entry := range previous {
+		if !currentSet[entry.ValidatorAddress] && entry.VotingPower != 0 {
+			changed = append(changed, types.NewValidatorSetEntry(entry.ValidatorAddress, 0, 0, height))
+		}
+	}
+
+	return changed
+}
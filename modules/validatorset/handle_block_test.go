@@ -0,0 +1,82 @@
+package validatorset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types"
+)
+
+func TestDiffValidatorSet(t *testing.T) {
+	previous := []types.ValidatorSetEntry{
+		types.NewValidatorSetEntry("val1", 100, 10, 9),
+		types.NewValidatorSetEntry("val2", 200, -10, 9),
+	}
+
+	testCases := []struct {
+		name     string
+		previous []types.ValidatorSetEntry
+		current  []types.ValidatorSetEntry
+		expected []types.ValidatorSetEntry
+	}{
+		{
+			name:     "unchanged set ignores proposer priority",
+			previous: previous,
+			current: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 100, -5, 10),
+				types.NewValidatorSetEntry("val2", 200, 5, 10),
+			},
+			expected: nil,
+		},
+		{
+			name:     "changed voting power",
+			previous: previous,
+			current: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 150, 10, 10),
+				types.NewValidatorSetEntry("val2", 200, -10, 10),
+			},
+			expected: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 150, 10, 10),
+			},
+		},
+		{
+			name:     "joined and left validators",
+			previous: previous,
+			current: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 100, 10, 10),
+				types.NewValidatorSetEntry("val3", 50, 0, 10),
+			},
+			expected: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val3", 50, 0, 10),
+				types.NewValidatorSetEntry("val2", 0, 0, 10),
+			},
+		},
+		{
+			name:     "empty previous set",
+			previous: nil,
+			current: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 100, 10, 1),
+			},
+			expected: []types.ValidatorSetEntry{
+				types.NewValidatorSetEntry("val1", 100, 10, 1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			height := tc.current[0].Height
+			require.Equal(t, tc.expected, diffValidatorSet(tc.previous, tc.current, height))
+		})
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Equal(t, ModeAll, cfg.Mode)
+	require.NoError(t, cfg.Validate())
+
+	require.Error(t, NewConfig("invalid").Validate())
+}
@@ -0,0 +1,53 @@
+package validatorset
+
+import (
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module      = &Module{}
+	_ modules.BlockModule = &Module{}
+)
+
+// Module represents the module that stores the validator set of each height
+type Module struct {
+	cfg  *Config
+	db   database.Database
+	node node.Node
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db database.Database, node node.Node) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	validatorSetCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if validatorSetCfg == nil {
+		validatorSetCfg = DefaultConfig()
+	}
+
+	err = validatorSetCfg.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg:  validatorSetCfg,
+		db:   db,
+		node: node,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "validator_set"
+}
@@ -0,0 +1,61 @@
+package verify
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ModeSample = "sample"
+	ModeSweep  = "sweep"
+)
+
+// Config contains the configuration of the consistency verification
+type Config struct {
+	Mode         string `yaml:"mode"`
+	Interval     int64  `yaml:"interval"`
+	SampleSize   int64  `yaml:"sample_size"`
+	BatchSize    int64  `yaml:"batch_size"`
+	CheckCommits bool   `yaml:"check_commits"`
+	Reenqueue    bool   `yaml:"reenqueue"`
+}
+
+// NewConfig allows to build a new Config instance
+func NewConfig(mode string, interval, sampleSize, batchSize int64, checkCommits, reenqueue bool) *Config {
+	return &Config{
+		Mode:         mode,
+		Interval:     interval,
+		SampleSize:   sampleSize,
+		BatchSize:    batchSize,
+		CheckCommits: checkCommits,
+		Reenqueue:    reenqueue,
+	}
+}
+
+// DefaultConfig returns the default verification configuration
+func DefaultConfig() *Config {
+	return NewConfig(ModeSample, 10, 100, 1000, true, false)
+}
+
+// Validate checks whether the configuration is valid
+func (c *Config) Validate() error {
+	if c.Mode != ModeSample && c.Mode != ModeSweep {
+		return fmt.Errorf("invalid verify mode: %s", c.Mode)
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("invalid verify interval: it must be greater than 0")
+	}
+
+	return nil
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"verify"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
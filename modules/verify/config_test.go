@@ -0,0 +1,38 @@
+package verify_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/modules/verify"
+)
+
+func TestParseConfig(t *testing.T) {
+	data := []byte(`
+verify:
+  mode: sweep
+  interval: 5
+  sample_size: 10
+  batch_size: 500
+  check_commits: true
+  reenqueue: true
+`)
+
+	cfg, err := verify.ParseConfig(data)
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg)
+	require.Equal(t, verify.ModeSweep, cfg.Mode)
+	require.Equal(t, int64(5), cfg.Interval)
+	require.Equal(t, int64(10), cfg.SampleSize)
+	require.Equal(t, int64(500), cfg.BatchSize)
+	require.True(t, cfg.CheckCommits)
+	require.True(t, cfg.Reenqueue)
+	require.NoError(t, cfg.Validate())
+
+	data = []byte(`invalid_field: yes`)
+	cfg, err = verify.ParseConfig(data)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+}
@@ -0,0 +1,139 @@
+package verify
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/njuno/modules/utils"
+	"github.com/forbole/njuno/types"
+	"github.com/forbole/njuno/types/config"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "verify").Msg("setting up periodic tasks")
+
+	err := m.cfg.Validate()
+	if err != nil {
+		return err
+	}
+
+	if _, err := scheduler.Every(int(m.cfg.Interval)).Minutes().Do(func() {
+		utils.WatchMethod(m.verify)
+	}); err != nil {
+		return fmt.Errorf("error while setting up verify periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// verify verifies a set of stored heights based on the configured mode
+func (m *Module) verify() error {
+	_, verificationDb, err := m.getDatabases()
+	if err != nil {
+		return err
+	}
+
+	// Skip the latest stored height since its commit signatures are stored only with the following block
+	lastHeight, err := m.db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+	lastHeight--
+
+	firstHeight := config.Cfg.Parser.StartHeight
+	if firstHeight < 1 {
+		firstHeight = 1
+	}
+
+	if lastHeight < firstHeight {
+		return nil
+	}
+
+	var heights []int64
+	switch m.cfg.Mode {
+	case ModeSample:
+		for i := int64(0); i < m.cfg.SampleSize; i++ {
+			heights = append(heights, firstHeight+rand.Int63n(lastHeight-firstHeight+1))
+		}
+
+	case ModeSweep:
+		lastVerified, err := verificationDb.GetLastVerifiedHeight()
+		if err != nil {
+			return err
+		}
+
+		if lastVerified >= firstHeight {
+			firstHeight = lastVerified + 1
+		}
+
+		for height := firstHeight; height <= lastHeight && height < firstHeight+m.cfg.BatchSize; height++ {
+			heights = append(heights, height)
+		}
+	}
+
+	log.Debug().Str("module", "verify").Str("mode", m.cfg.Mode).Int("heights", len(heights)).
+		Msg("verifying stored data")
+
+	for _, height := range heights {
+		issues, err := m.VerifyHeight(height, m.cfg.CheckCommits)
+		if err != nil {
+			return err
+		}
+
+		err = m.reportIssues(issues)
+		if err != nil {
+			return err
+		}
+
+		if m.cfg.Mode == ModeSweep {
+			err = verificationDb.StoreLastVerifiedHeight(height)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reportIssues stores the given issues, re-enqueueing the affected heights if required
+func (m *Module) reportIssues(issues []types.VerificationIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	_, verificationDb, err := m.getDatabases()
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		log.Info().Str("module", "verify").Int64("height", issue.Height).Str("type", string(issue.Type)).
+			Str("expected", issue.Expected).Str("actual", issue.Actual).Msg("found verification issue")
+	}
+
+	if m.cfg.Reenqueue && m.reprocessQueue != nil {
+		// Never block when the queue is full, so that a slow reprocessing does not stall the periodic operation.
+		// The issues whose height could not be enqueued are stored as not re-enqueued
+		enqueued := map[int64]bool{}
+		for _, height := range m.ReprocessHeights(issues) {
+			select {
+			case m.reprocessQueue <- height:
+				enqueued[height] = true
+			default:
+				log.Warn().Str("module", "verify").Int64("height", height).
+					Msg("reprocess queue is full, skipping height")
+			}
+		}
+
+		for i := range issues {
+			issues[i].Reenqueued = enqueued[issues[i].ReprocessHeight()]
+		}
+	}
+
+	return verificationDb.SaveVerificationIssues(issues)
+}
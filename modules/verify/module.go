@@ -0,0 +1,73 @@
+package verify
+
+import (
+	"fmt"
+
+	"github.com/forbole/njuno/database"
+	"github.com/forbole/njuno/modules"
+	"github.com/forbole/njuno/node"
+	"github.com/forbole/njuno/types"
+	"github.com/forbole/njuno/types/config"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+	_ modules.ReprocessModule          = &Module{}
+)
+
+// Module represents the module that periodically verifies the stored data against the chain
+type Module struct {
+	cfg            *Config
+	node           node.Node
+	db             database.Database
+	reprocessQueue types.HeightQueue
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, node node.Node, db database.Database) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	verifyCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if verifyCfg == nil {
+		verifyCfg = DefaultConfig()
+	}
+
+	return &Module{
+		cfg:  verifyCfg,
+		node: node,
+		db:   db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "verify"
+}
+
+// SetReprocessQueue implements modules.ReprocessModule
+func (m *Module) SetReprocessQueue(queue types.HeightQueue) {
+	m.reprocessQueue = queue
+}
+
+// getDatabases returns the database casted to the interfaces required to perform the verification
+func (m *Module) getDatabases() (database.ExportDb, database.VerificationDb, error) {
+	exportDb, ok := m.db.(database.ExportDb)
+	if !ok {
+		return nil, nil, fmt.Errorf("verify is enabled, but your database does not implement ExportDb")
+	}
+
+	verificationDb, ok := m.db.(database.VerificationDb)
+	if !ok {
+		return nil, nil, fmt.Errorf("verify is enabled, but your database does not implement VerificationDb")
+	}
+
+	return exportDb, verificationDb, nil
+}
@@ -0,0 +1,157 @@
+package verify
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/forbole/njuno/types"
+)
+
+// VerifyHeight compares the data stored for the given height against the one returned by the node,
+// and returns all the found inconsistencies.
+// If checkCommits is true, the stored commit signatures of the height are verified as well.
+func (m *Module) VerifyHeight(height int64, checkCommits bool) ([]types.VerificationIssue, error) {
+	exportDb, _, err := m.getDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := m.node.Block(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting block %d from the node: %s", height, err)
+	}
+
+	now := time.Now().UTC()
+
+	rows, err := exportDb.GetBlocksInRange(height, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return []types.VerificationIssue{
+			types.NewVerificationIssue(height, types.VerificationIssueMissingBlock, block.Block.Hash().String(), "", now),
+		}, nil
+	}
+
+	var issues []types.VerificationIssue
+	stored := rows[0]
+
+	if expected := block.Block.Hash().String(); stored.Hash != expected {
+		issues = append(issues, types.NewVerificationIssue(
+			height, types.VerificationIssueHashMismatch, expected, stored.Hash, now,
+		))
+	}
+
+	if expected := int64(len(block.Block.Txs)); stored.TxNum != expected {
+		issues = append(issues, types.NewVerificationIssue(
+			height, types.VerificationIssueNumTxsMismatch, fmt.Sprint(expected), fmt.Sprint(stored.TxNum), now,
+		))
+	}
+
+	// Compare the transactions hashes
+	var expectedTxs []string
+	for _, tx := range block.Block.Txs {
+		expectedTxs = append(expectedTxs, fmt.Sprintf("%X", tx.Hash()))
+	}
+
+	txRows, err := exportDb.GetTransactionsInRange(height, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var storedTxs []string
+	for _, row := range txRows {
+		storedTxs = append(storedTxs, row.Hash)
+	}
+
+	if missing, unexpected := diff(expectedTxs, storedTxs); len(missing) > 0 || len(unexpected) > 0 {
+		issues = append(issues, types.NewVerificationIssue(
+			height, types.VerificationIssueTransactionsMismatch,
+			strings.Join(missing, ","), strings.Join(unexpected, ","), now,
+		))
+	}
+
+	if !checkCommits {
+		return issues, nil
+	}
+
+	// The commit signatures of this height are contained inside the following block
+	nextBlock, err := m.node.Block(height + 1)
+	if err != nil {
+		// The next block might not be available yet, so we just skip the commits check
+		return issues, nil
+	}
+
+	var expectedSigners []string
+	for _, commitSig := range nextBlock.Block.LastCommit.Signatures {
+		if commitSig.Signature == nil {
+			continue
+		}
+		expectedSigners = append(expectedSigners, types.ConvertValidatorAddressToBech32String(commitSig.ValidatorAddress))
+	}
+
+	preCommits, err := exportDb.GetPreCommitsInRange(height, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var storedSigners []string
+	for _, preCommit := range preCommits {
+		storedSigners = append(storedSigners, preCommit.ValidatorAddress)
+	}
+
+	if missing, unexpected := diff(expectedSigners, storedSigners); len(missing) > 0 || len(unexpected) > 0 {
+		issues = append(issues, types.NewVerificationIssue(
+			height, types.VerificationIssueCommitSignersMismatch,
+			strings.Join(missing, ","), strings.Join(unexpected, ","), now,
+		))
+	}
+
+	return issues, nil
+}
+
+// diff returns the values of expected that are not contained inside actual, and the values
+// of actual that are not contained inside expected
+func diff(expected, actual []string) (missing []string, unexpected []string) {
+	expectedSet := make(map[string]bool, len(expected))
+	for _, value := range expected {
+		expectedSet[value] = true
+	}
+
+	actualSet := make(map[string]bool, len(actual))
+	for _, value := range actual {
+		actualSet[value] = true
+		if !expectedSet[value] {
+			unexpected = append(unexpected, value)
+		}
+	}
+
+	for _, value := range expected {
+		if !actualSet[value] {
+			missing = append(missing, value)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	return missing, unexpected
+}
+
+// ReprocessHeights returns the heights that should be parsed again in order to fix the given issues.
+// The stale data of each height is removed by the parser right before the height is parsed again.
+func (m *Module) ReprocessHeights(issues []types.VerificationIssue) []int64 {
+	var heights []int64
+	seen := map[int64]bool{}
+	for _, issue := range issues {
+		height := issue.ReprocessHeight()
+		if !seen[height] {
+			seen[height] = true
+			heights = append(heights, height)
+		}
+	}
+
+	return heights
+}
@@ -10,9 +10,14 @@ import (
 
 type Node interface {
 
-	// AccountBalance queries for the balance of given address.
+	// AccountBalance queries for the balance of given address at the given height.
+	// If the height is not positive, the latest balance is returned.
 	// An error is returned if the query fails.
-	AccountBalance(address string) (sdk.Coins, error)
+	AccountBalance(address string, height int64) (sdk.Coins, error)
+
+	// Accounts queries the addresses of all the accounts existing on chain.
+	// An error is returned if the query fails.
+	Accounts() ([]string, error)
 
 	// Block queries for a block by height.
 	// An error is returned if the query fails.
@@ -26,22 +31,53 @@ type Node interface {
 	// An error is returned if the query fails.
 	ConsensusState() (*constypes.RoundStateSimple, error)
 
+	// Delegations queries all the delegations of the given address at the given height.
+	// If the height is not positive, the latest delegations are returned.
+	// An error is returned if the query fails.
+	Delegations(address string, height int64) ([]types.DelegationResponse, error)
+
 	// Genesis returns the genesis state.
 	// An error is returned if the query fails.
 	Genesis() (*tmctypes.ResultGenesis, error)
 
+	// IBCChannels queries all the IBC channels.
+	// An error is returned if the query fails.
+	IBCChannels() ([]types.IdentifiedChannelResponse, error)
+
+	// IBCClientStates queries all the IBC light clients.
+	// An error is returned if the query fails.
+	IBCClientStates() ([]types.IdentifiedClientStateResponse, error)
+
+	// IBCConnections queries all the IBC connections.
+	// An error is returned if the query fails.
+	IBCConnections() ([]types.IdentifiedConnectionResponse, error)
+
+	// IBCConsensusState queries the consensus state of the given IBC light client at the given height.
+	// An error is returned if the query fails.
+	IBCConsensusState(clientID string, height types.IBCHeightResponse) (types.QueryConsensusStateResponse, error)
+
+	// IBCDenomTraces queries all the IBC denom traces.
+	// An error is returned if the query fails.
+	IBCDenomTraces() ([]types.DenomTraceResponse, error)
+
 	// IBCTransferParams queries the latest ibc parameters.
 	// An error is returned if the query fails.
 	IBCTransferParams() (types.IBCTransfer, error)
 
-	// Inflation queries the latest inflation value.
+	// Inflation queries the inflation value at the given height.
+	// If the height is not positive, the latest inflation is returned.
 	// An error is returned if the query fails.
-	Inflation() (string, error)
+	Inflation(height int64) (string, error)
 
 	// LatestHeight returns the latest block height on the active chain.
 	// An error is returned if the query fails.
 	LatestHeight() (int64, error)
 
+	// Redelegations queries all the redelegations of the given address at the given height.
+	// If the height is not positive, the latest redelegations are returned.
+	// An error is returned if the query fails.
+	Redelegations(address string, height int64) ([]types.RedelegationResponse, error)
+
 	// StakingPool queries the latest staking pool value.
 	// An error is returned if the query fails.
 	StakingPool() (stakingtypes.Pool, error)
@@ -49,13 +85,23 @@ type Node interface {
 	// Stop defers the node stop execution to the client.
 	Stop()
 
-	// Supply queries the latest supply value.
+	// Supply queries the total supply of all the denoms at the given height.
+	// If the height is not positive, the latest supply is returned.
 	// An error is returned if the query fails.
-	Supply() (sdk.Coins, error)
+	Supply(height int64) (sdk.Coins, error)
 
 	// TotalDelegations queries the total value of delegated tokens
 	// for given address. An error is returned if the query fails.
-	TotalDelegations(address string) (sdk.Coin, error)
+	TotalDelegations(address string) (sdk.Coins, error)
+
+	// UnbondingDelegations queries all the unbonding delegations of the given address at the given height.
+	// If the height is not positive, the latest unbonding delegations are returned.
+	// An error is returned if the query fails.
+	UnbondingDelegations(address string, height int64) ([]types.UnbondingDelegationResponse, error)
+
+	// ValidatorDelegations queries all the delegations made to the validator having the given address.
+	// An error is returned if the query fails.
+	ValidatorDelegations(validatorAddress string) ([]types.DelegationResponse, error)
 
 	// Validators returns all the known Tendermint validators for a given block
 	// height. An error is returned if the query fails.
@@ -0,0 +1,33 @@
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/forbole/njuno/types"
+)
+
+// Accounts implements node.Node
+func (cp *Node) Accounts() ([]string, error) {
+	var addresses []string
+	err := queryAllPages(fmt.Sprintf("%s/cosmos/auth/v1beta1/accounts", cp.RESTNode), func(bz []byte) ([]byte, error) {
+		var res types.QueryAccountsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling accounts: %s", err)
+		}
+
+		for _, account := range res.Accounts {
+			if address := account.GetAddress(); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting accounts: %s", err)
+	}
+
+	return addresses, nil
+}
@@ -3,28 +3,18 @@ package remote
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/forbole/njuno/types"
 )
 
 // AccountBalance implements node.Node
-func (cp *Node) AccountBalance(address string) (sdk.Coins, error) {
-	resp, err := http.Get(fmt.Sprintf("%s/cosmos/bank/v1beta1/balances/%s", cp.RESTNode, address))
+func (cp *Node) AccountBalance(address string, height int64) (sdk.Coins, error) {
+	bz, err := queryAtHeight(fmt.Sprintf("%s/cosmos/bank/v1beta1/balances/%s", cp.RESTNode, address), height)
 	if err != nil {
 		return sdk.Coins{}, fmt.Errorf("error while getting account balance of address %s: %s", address, err)
 	}
 
-	defer resp.Body.Close()
-
-	bz, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return sdk.Coins{}, fmt.Errorf("error while processing account balance of address %s: %s", address, err)
-	}
-
 	var balance types.QueryAllBalancesResponse
 	err = json.Unmarshal(bz, &balance)
 	if err != nil {
@@ -37,26 +27,21 @@ func (cp *Node) AccountBalance(address string) (sdk.Coins, error) {
 // -------------------------------------------------------------------------------------------------------------------
 
 // Supply implements node.Node
-func (cp *Node) Supply() (sdk.Coins, error) {
-	resp, err := http.Get(fmt.Sprintf("%s/cosmos/bank/v1beta1/supply/unom", cp.RESTNode))
-	if err != nil {
-		return sdk.Coins{}, fmt.Errorf("error while getting total supply: %s", err)
-	}
-
-	defer resp.Body.Close()
-
-	bz, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return sdk.Coins{}, fmt.Errorf("error while processing total supply: %s", err)
-	}
-
-	var supply *banktypes.QuerySupplyOfResponse
-	err = json.Unmarshal(bz, &supply)
+func (cp *Node) Supply(height int64) (sdk.Coins, error) {
+	var supply sdk.Coins
+	err := queryAllPagesAtHeight(fmt.Sprintf("%s/cosmos/bank/v1beta1/supply", cp.RESTNode), height, func(bz []byte) ([]byte, error) {
+		var res types.QueryTotalSupplyResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling supply: %s", err)
+		}
+
+		supply = supply.Add(res.Supply...)
+		return res.Pagination.NextKey, nil
+	})
 	if err != nil {
-		return sdk.Coins{}, fmt.Errorf("error while unmarshaling supply: %s", err)
+		return nil, fmt.Errorf("error while getting total supply at height %d: %s", height, err)
 	}
-	var totalSupply []sdk.Coin
-	totalSupply = append(totalSupply, supply.Amount)
 
-	return totalSupply, nil
+	return supply, nil
 }
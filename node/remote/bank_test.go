@@ -0,0 +1,32 @@
+package remote
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types"
+)
+
+func TestNode_Supply(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/cosmos/bank/v1beta1/supply", r.URL.Path)
+		require.Equal(t, "100", r.Header.Get(grpctypes.GRPCBlockHeightHeader))
+
+		res := types.QueryTotalSupplyResponse{
+			Supply: sdk.NewCoins(sdk.NewInt64Coin("unom", 1000)),
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer server.Close()
+
+	node := &Node{RESTNode: server.URL}
+	supply, err := node.Supply(100)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unom", 1000)), supply)
+}
@@ -31,3 +31,102 @@ func (cp *Node) IBCTransferParams() (types.IBCTransfer, error) {
 
 	return params, nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// IBCClientStates implements node.Node
+func (cp *Node) IBCClientStates() ([]types.IdentifiedClientStateResponse, error) {
+	var clients []types.IdentifiedClientStateResponse
+	err := queryAllPages(fmt.Sprintf("%s/ibc/core/client/v1/client_states", cp.RESTNode), func(bz []byte) ([]byte, error) {
+		var res types.QueryClientStatesResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling ibc client states: %s", err)
+		}
+
+		clients = append(clients, res.ClientStates...)
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting ibc client states: %s", err)
+	}
+
+	return clients, nil
+}
+
+// IBCConsensusState implements node.Node
+func (cp *Node) IBCConsensusState(clientID string, height types.IBCHeightResponse) (types.QueryConsensusStateResponse, error) {
+	bz, err := queryAtHeight(fmt.Sprintf("%s/ibc/core/client/v1/consensus_states/%s/revision/%d/height/%d",
+		cp.RESTNode, clientID, height.RevisionNumber, height.RevisionHeight), 0)
+	if err != nil {
+		return types.QueryConsensusStateResponse{}, fmt.Errorf("error while getting consensus state of client %s: %s", clientID, err)
+	}
+
+	var res types.QueryConsensusStateResponse
+	err = json.Unmarshal(bz, &res)
+	if err != nil {
+		return types.QueryConsensusStateResponse{}, fmt.Errorf("error while unmarshaling consensus state of client %s: %s", clientID, err)
+	}
+
+	return res, nil
+}
+
+// IBCConnections implements node.Node
+func (cp *Node) IBCConnections() ([]types.IdentifiedConnectionResponse, error) {
+	var connections []types.IdentifiedConnectionResponse
+	err := queryAllPages(fmt.Sprintf("%s/ibc/core/connection/v1/connections", cp.RESTNode), func(bz []byte) ([]byte, error) {
+		var res types.QueryConnectionsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling ibc connections: %s", err)
+		}
+
+		connections = append(connections, res.Connections...)
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting ibc connections: %s", err)
+	}
+
+	return connections, nil
+}
+
+// IBCChannels implements node.Node
+func (cp *Node) IBCChannels() ([]types.IdentifiedChannelResponse, error) {
+	var channels []types.IdentifiedChannelResponse
+	err := queryAllPages(fmt.Sprintf("%s/ibc/core/channel/v1/channels", cp.RESTNode), func(bz []byte) ([]byte, error) {
+		var res types.QueryChannelsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling ibc channels: %s", err)
+		}
+
+		channels = append(channels, res.Channels...)
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting ibc channels: %s", err)
+	}
+
+	return channels, nil
+}
+
+// IBCDenomTraces implements node.Node
+func (cp *Node) IBCDenomTraces() ([]types.DenomTraceResponse, error) {
+	var traces []types.DenomTraceResponse
+	err := queryAllPages(fmt.Sprintf("%s/ibc/apps/transfer/v1/denom_traces", cp.RESTNode), func(bz []byte) ([]byte, error) {
+		var res types.QueryDenomTracesResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling ibc denom traces: %s", err)
+		}
+
+		traces = append(traces, res.DenomTraces...)
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting ibc denom traces: %s", err)
+	}
+
+	return traces, nil
+}
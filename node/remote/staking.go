@@ -26,13 +26,13 @@ func (cp *Node) StakingPool() (stakingtypes.Pool, error) {
 		return stakingtypes.Pool{}, fmt.Errorf("error while processing staking pool: %s", err)
 	}
 
-	var stakingPool stakingtypes.Pool
+	var stakingPool stakingtypes.QueryPoolResponse
 	err = json.Unmarshal(bz, &stakingPool)
 	if err != nil {
 		return stakingtypes.Pool{}, fmt.Errorf("error while unmarshaling staking pool: %s", err)
 	}
 
-	return stakingPool, nil
+	return stakingPool.Pool, nil
 }
 
 // -------------------------------------------------------------------------------------------------------------------
@@ -62,24 +62,93 @@ func (cp *Node) Validators(height int64) (*tmctypes.ResultValidators, error) {
 }
 
 // TotalDelegations implements node.Node
-func (cp *Node) TotalDelegations(address string) (sdk.Coin, error) {
-	resp, err := http.Get(fmt.Sprintf("%s/cosmos/staking/v1beta1/delegations/%s", cp.RESTNode, address))
+func (cp *Node) TotalDelegations(address string) (sdk.Coins, error) {
+	delegations, err := cp.Delegations(address, 0)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("error while getting total delegations value of address %s: %s", address, err)
+		return nil, fmt.Errorf("error while getting total delegations value of address %s: %s", address, err)
 	}
 
-	defer resp.Body.Close()
+	total := sdk.NewCoins()
+	for _, delegation := range delegations {
+		total = total.Add(delegation.Balance)
+	}
 
-	bz, err := ioutil.ReadAll(resp.Body)
+	return total, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// Delegations implements node.Node
+func (cp *Node) Delegations(address string, height int64) ([]types.DelegationResponse, error) {
+	return cp.queryDelegations(fmt.Sprintf("%s/cosmos/staking/v1beta1/delegations/%s", cp.RESTNode, address), height)
+}
+
+// ValidatorDelegations implements node.Node
+func (cp *Node) ValidatorDelegations(validatorAddress string) ([]types.DelegationResponse, error) {
+	return cp.queryDelegations(fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/delegations", cp.RESTNode, validatorAddress), 0)
+}
+
+// queryDelegations returns all the delegations returned by the given endpoint at the given height,
+// going through all the pages
+func (cp *Node) queryDelegations(endpoint string, height int64) ([]types.DelegationResponse, error) {
+	var delegations []types.DelegationResponse
+	err := queryAllPagesAtHeight(endpoint, height, func(bz []byte) ([]byte, error) {
+		var res types.QueryDelegationsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling delegations: %s", err)
+		}
+
+		delegations = append(delegations, res.DelegationResponses...)
+		return res.Pagination.NextKey, nil
+	})
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("error while processing total delegations value of address %s: %s", address, err)
+		return nil, err
 	}
 
-	var delegation types.QueryTotalDelegationsResponse
-	err = json.Unmarshal(bz, &delegation)
+	return delegations, nil
+}
+
+// UnbondingDelegations implements node.Node
+func (cp *Node) UnbondingDelegations(address string, height int64) ([]types.UnbondingDelegationResponse, error) {
+	endpoint := fmt.Sprintf("%s/cosmos/staking/v1beta1/delegators/%s/unbonding_delegations", cp.RESTNode, address)
+
+	var unbondings []types.UnbondingDelegationResponse
+	err := queryAllPagesAtHeight(endpoint, height, func(bz []byte) ([]byte, error) {
+		var res types.QueryUnbondingDelegationsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling unbonding delegations of address %s: %s", address, err)
+		}
+
+		unbondings = append(unbondings, res.UnbondingResponses...)
+		return res.Pagination.NextKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unbondings, nil
+}
+
+// Redelegations implements node.Node
+func (cp *Node) Redelegations(address string, height int64) ([]types.RedelegationResponse, error) {
+	endpoint := fmt.Sprintf("%s/cosmos/staking/v1beta1/delegators/%s/redelegations", cp.RESTNode, address)
+
+	var redelegations []types.RedelegationResponse
+	err := queryAllPagesAtHeight(endpoint, height, func(bz []byte) ([]byte, error) {
+		var res types.QueryRedelegationsResponse
+		err := json.Unmarshal(bz, &res)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling redelegations of address %s: %s", address, err)
+		}
+
+		redelegations = append(redelegations, res.RedelegationResponses...)
+		return res.Pagination.NextKey, nil
+	})
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("error while unmarshaling total delegations value of address %s: %s", address, err)
+		return nil, err
 	}
 
-	return delegation.DelegationResponses[0].Balance, nil
+	return redelegations, nil
 }
@@ -0,0 +1,75 @@
+package remote
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// queryAtHeight queries the given REST endpoint at the given height, returning the response body.
+// If the height is not positive, the latest state is queried instead.
+func queryAtHeight(endpoint string, height int64) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while building request of %s: %s", endpoint, err)
+	}
+
+	if height > 0 {
+		req.Header.Set(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying %s: %s", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading response of %s: %s", endpoint, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d: %s", endpoint, resp.StatusCode, bz)
+	}
+
+	return bz, nil
+}
+
+// queryAllPages queries the given paginated REST endpoint until no next key is returned.
+// Each page body is passed to the given handler, which returns the key of the next page.
+func queryAllPages(endpoint string, handlePage func(bz []byte) ([]byte, error)) error {
+	return queryAllPagesAtHeight(endpoint, 0, handlePage)
+}
+
+// queryAllPagesAtHeight behaves like queryAllPages, querying all the pages at the given height.
+// If the height is not positive, the latest state is queried instead.
+func queryAllPagesAtHeight(endpoint string, height int64, handlePage func(bz []byte) ([]byte, error)) error {
+	var nextKey []byte
+	for {
+		pageURL := endpoint
+		if len(nextKey) != 0 {
+			key := base64.StdEncoding.EncodeToString(nextKey)
+			pageURL = fmt.Sprintf("%s?pagination.key=%s", endpoint, url.QueryEscape(key))
+		}
+
+		bz, err := queryAtHeight(pageURL, height)
+		if err != nil {
+			return err
+		}
+
+		nextKey, err = handlePage(bz)
+		if err != nil {
+			return err
+		}
+
+		if len(nextKey) == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package remote
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/njuno/types"
+)
+
+func TestQueryAllPagesAtHeight(t *testing.T) {
+	nextKey := []byte{0x01, 0xff, 0x02}
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "100", r.Header.Get(grpctypes.GRPCBlockHeightHeader))
+
+		key := r.URL.Query().Get("pagination.key")
+		keys = append(keys, key)
+
+		var res types.QueryDelegationsResponse
+		if key == "" {
+			res.Pagination.NextKey = nextKey
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer server.Close()
+
+	pages := 0
+	err := queryAllPagesAtHeight(server.URL, 100, func(bz []byte) ([]byte, error) {
+		pages++
+
+		var res types.QueryDelegationsResponse
+		err := json.Unmarshal(bz, &res)
+		return res.Pagination.NextKey, err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, pages)
+	require.Equal(t, []string{"", base64.StdEncoding.EncodeToString(nextKey)}, keys)
+}
@@ -72,6 +72,20 @@ func (w Worker) Start() {
 	}
 }
 
+// StartReprocessing starts a worker by listening for jobs (block heights) from the given worker queue,
+// exporting the data of each height again even if it has already been stored. Any failed job is logged and re-enqueued.
+func (w Worker) StartReprocessing() {
+	for i := range w.queue {
+		if err := w.Reprocess(i); err != nil {
+			// re-enqueue any failed job
+			go func(height int64) {
+				w.logger.Error("re-enqueueing failed block", "height", height, "err", err)
+				w.queue <- height
+			}(i)
+		}
+	}
+}
+
 // ProcessIfNotExists defines the job consumer workflow. It will fetch a block for a given
 // height and associated metadata and export it to a database if it does not exist yet.
 // It returns an error if any export process fails.
@@ -128,6 +142,73 @@ func (w Worker) Process(height int64) error {
 	return w.ExportBlock(block, events, txs, vals)
 }
 
+// Reprocess fetches the block having the given height along with its commit and transactions, and stores them
+// inside the database again replacing the existing data. Differently from Process, the modules handlers are
+// not called, since running them twice on the same height would corrupt the data of the non-idempotent modules.
+// If the block has never been stored, the modules handlers have never been called for it either, so the height
+// is processed as usual instead.
+// It returns an error if any export process fails.
+func (w Worker) Reprocess(height int64) error {
+	if height == 0 {
+		return nil
+	}
+
+	exists, err := w.db.HasBlock(height)
+	if err != nil {
+		return fmt.Errorf("error while searching for block: %s", err)
+	}
+
+	if !exists {
+		return w.Process(height)
+	}
+
+	verificationDb, ok := w.db.(database.VerificationDb)
+	if !ok {
+		return fmt.Errorf("reprocessing is enabled, but your database does not implement VerificationDb")
+	}
+
+	w.logger.Debug("reprocessing block", "height", height)
+
+	block, err := w.node.Block(height)
+	if err != nil {
+		return fmt.Errorf("failed to get block from node: %s", err)
+	}
+
+	txs, err := w.UnmarshalTxs(block)
+	if err != nil {
+		return fmt.Errorf("failed to get transactions for block: %s", err)
+	}
+
+	vals, err := w.node.Validators(height)
+	if err != nil {
+		return fmt.Errorf("failed to get validators for block: %s", err)
+	}
+
+	err = verificationDb.DeleteReprocessedData(height)
+	if err != nil {
+		return err
+	}
+
+	err = w.db.SaveBlock(types.NewBlockFromTmBlock(block, 0))
+	if err != nil {
+		return fmt.Errorf("failed to persist block: %s", err)
+	}
+
+	err = w.ExportCommit(block.Block.LastCommit, vals)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		err = w.db.SaveTx(tx)
+		if err != nil {
+			return fmt.Errorf("failed to handle transaction with hash %s: %s", tx.Hash, err)
+		}
+	}
+
+	return nil
+}
+
 // ProcessTransactions fetches transactions for a given height and stores them into the database.
 // It returns an error if the export process fails.
 func (w Worker) ProcessTransactions(height int64) error {
@@ -239,11 +320,23 @@ func (w Worker) ExportCommit(commit *tmtypes.Commit, vals *tmctypes.ResultValida
 func (w Worker) ExportTxs(txs []types.TxResponse) error {
 	// Handle all transactions inside the block
 	for _, tx := range txs {
+		tx := tx
+
 		// Save  transaction in database
 		err := w.db.SaveTx(tx)
 		if err != nil {
 			return fmt.Errorf("failed to handle transaction with hash %s: %s", tx.Hash, err)
 		}
+
+		// Call the tx handlers
+		for _, module := range w.modules {
+			if transactionModule, ok := module.(modules.TransactionModule); ok {
+				err = transactionModule.HandleTx(&tx)
+				if err != nil {
+					w.logger.TxError(module, &tx, err)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -251,11 +344,12 @@ func (w Worker) ExportTxs(txs []types.TxResponse) error {
 
 // UnmarshalTxs process all transactions contained in a block
 func (w Worker) UnmarshalTxs(block *tmctypes.ResultBlock) ([]types.TxResponse, error) {
-	txResponses := make([]types.TxResponse, len(block.Block.Txs))
+	txResponses := make([]types.TxResponse, 0, len(block.Block.Txs))
 
 	// get tx details from the block
-	var transaction types.TxResponse
 	for _, t := range block.Block.Txs {
+		// Use a fresh value for each tx so that no field is carried over from the previous one
+		var transaction types.TxResponse
 		_ = json.Unmarshal(t, &transaction)
 
 		txResponses = append(txResponses, types.NewTxResponse(transaction.Fee, transaction.Memo, transaction.Msg, transaction.Signatures, fmt.Sprintf("%X", t.Hash()), block.Block.Height))
@@ -0,0 +1,38 @@
+package parser_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/njuno/parser"
+)
+
+func TestWorker_UnmarshalTxs(t *testing.T) {
+	txs := tmtypes.Txs{
+		tmtypes.Tx(`{"fee":{"gas":"100"},"memo":"first","msg":[{"type":"nomic/MsgDelegate","value":{"delegator_address":"nomic1a"}}]}`),
+		tmtypes.Tx(`{"fee":{"gas":"50"},"msg":[]}`),
+	}
+	block := &tmctypes.ResultBlock{Block: &tmtypes.Block{
+		Header: tmtypes.Header{Height: 10},
+		Data:   tmtypes.Data{Txs: txs},
+	}}
+
+	responses, err := parser.Worker{}.UnmarshalTxs(block)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+
+	require.Equal(t, fmt.Sprintf("%X", txs[0].Hash()), responses[0].Hash)
+	require.Equal(t, int64(10), responses[0].Height)
+	require.Equal(t, "first", responses[0].Memo)
+	require.Len(t, responses[0].Msg, 1)
+
+	// The fields of the first transaction must not be carried over to the second one
+	require.Equal(t, fmt.Sprintf("%X", txs[1].Hash()), responses[1].Hash)
+	require.Equal(t, "50", responses[1].Fee.Gas)
+	require.Empty(t, responses[1].Memo)
+	require.Empty(t, responses[1].Msg)
+}
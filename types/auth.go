@@ -0,0 +1,35 @@
+package types
+
+// AccountResponse contains the data of an account returned by the auth accounts endpoint.
+// Depending on the account type, the address is contained inside a different field.
+type AccountResponse struct {
+	Address     string `json:"address"`
+	BaseAccount *struct {
+		Address string `json:"address"`
+	} `json:"base_account,omitempty"`
+	BaseVestingAccount *struct {
+		BaseAccount struct {
+			Address string `json:"address"`
+		} `json:"base_account"`
+	} `json:"base_vesting_account,omitempty"`
+}
+
+// GetAddress returns the address of the account, whatever its type is
+func (a AccountResponse) GetAddress() string {
+	switch {
+	case a.Address != "":
+		return a.Address
+	case a.BaseAccount != nil:
+		return a.BaseAccount.Address
+	case a.BaseVestingAccount != nil:
+		return a.BaseVestingAccount.BaseAccount.Address
+	default:
+		return ""
+	}
+}
+
+// QueryAccountsResponse contains a page of the accounts existing on chain
+type QueryAccountsResponse struct {
+	Accounts   []AccountResponse `json:"accounts"`
+	Pagination PageResponse      `json:"pagination"`
+}
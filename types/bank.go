@@ -2,6 +2,22 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+// AccountBalance represents the balance of an account at a given height
+type AccountBalance struct {
+	Address string
+	Balance sdk.Coins
+	Height  int64
+}
+
+// NewAccountBalance allows to build a new AccountBalance instance
+func NewAccountBalance(address string, balance sdk.Coins, height int64) AccountBalance {
+	return AccountBalance{
+		Address: address,
+		Balance: balance,
+		Height:  height,
+	}
+}
+
 // QueryAllBalancesResponse contains the account balance data
 type QueryAllBalancesResponse struct {
 	Balances   sdk.Coins     `json:"balances"`
@@ -12,3 +28,9 @@ type PageResponse struct {
 	NextKey []byte `json:"next_key,omitempty"`
 	Total   string `json:"total,omitempty"`
 }
+
+// QueryTotalSupplyResponse contains a page of the total supply of all the denoms
+type QueryTotalSupplyResponse struct {
+	Supply     sdk.Coins    `json:"supply"`
+	Pagination PageResponse `json:"pagination"`
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	BtcCheckpointStatusBuilding = "building"
+	BtcCheckpointStatusSigning  = "signing"
+	BtcCheckpointStatusComplete = "complete"
+)
+
+// BtcDeposit represents a Bitcoin deposit relayed to the nBTC bridge
+type BtcDeposit struct {
+	Txid            string
+	Vout            uint32
+	ReceiverAddress string
+
+	// Amount is the deposited amount, expressed in satoshis
+	Amount sdk.Int
+
+	// TxHash is the hash of the Nomic transaction that relayed the deposit, if any
+	TxHash string
+	Height int64
+}
+
+// NewBtcDeposit allows to build a new BtcDeposit instance
+func NewBtcDeposit(txid string, vout uint32, receiver string, amount sdk.Int, txHash string, height int64) BtcDeposit {
+	return BtcDeposit{
+		Txid:            txid,
+		Vout:            vout,
+		ReceiverAddress: receiver,
+		Amount:          amount,
+		TxHash:          txHash,
+		Height:          height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// BtcWithdrawal represents a withdrawal of nBTC to a Bitcoin address
+type BtcWithdrawal struct {
+	TxHash             string
+	MsgIndex           int
+	SenderAddress      string
+	DestinationAddress string
+	Amount             sdk.Coin
+	Height             int64
+}
+
+// NewBtcWithdrawal allows to build a new BtcWithdrawal instance
+func NewBtcWithdrawal(
+	txHash string, msgIndex int, sender, destination string, amount sdk.Coin, height int64,
+) BtcWithdrawal {
+	return BtcWithdrawal{
+		TxHash:             txHash,
+		MsgIndex:           msgIndex,
+		SenderAddress:      sender,
+		DestinationAddress: destination,
+		Amount:             amount,
+		Height:             height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// BtcSignatory represents a single signatory of the bridge reserve
+type BtcSignatory struct {
+	PubKey      string `json:"pubkey" yaml:"pubkey"`
+	VotingPower uint64 `json:"voting_power" yaml:"voting_power"`
+}
+
+// BtcSignatorySet represents the set of signatories controlling the bridge reserve during a checkpoint
+type BtcSignatorySet struct {
+	Index       uint32         `json:"index" yaml:"index"`
+	CreateTime  int64          `json:"create_time" yaml:"create_time"`
+	Signatories []BtcSignatory `json:"signatories" yaml:"signatories"`
+}
+
+// BtcCheckpoint represents a checkpoint of the bridge reserve, along with the signatory set that signs it
+type BtcCheckpoint struct {
+	Index        uint32          `json:"index" yaml:"index"`
+	Status       string          `json:"status" yaml:"status"`
+	Txid         string          `json:"txid,omitempty" yaml:"txid,omitempty"`
+	FeeRate      uint64          `json:"fee_rate" yaml:"fee_rate"`
+	SignatorySet BtcSignatorySet `json:"sigset" yaml:"sigset"`
+}
+
+// BtcCheckpoints contains the list of checkpoints returned by a bridge source
+type BtcCheckpoints struct {
+	Checkpoints []BtcCheckpoint `json:"checkpoints" yaml:"checkpoints"`
+}
@@ -81,3 +81,23 @@ func NewGenesis(chainID string, startTime time.Time, initialHeight int64) *Genes
 		InitialHeight: initialHeight,
 	}
 }
+
+// ----------------------------------------------------------------------------------------------------------
+
+// ValidatorSetEntry contains the data of a single validator inside the validator set of a given height
+type ValidatorSetEntry struct {
+	ValidatorAddress string
+	VotingPower      int64
+	ProposerPriority int64
+	Height           int64
+}
+
+// NewValidatorSetEntry allows to build a new ValidatorSetEntry instance
+func NewValidatorSetEntry(validatorAddress string, votingPower, proposerPriority int64, height int64) ValidatorSetEntry {
+	return ValidatorSetEntry{
+		ValidatorAddress: validatorAddress,
+		VotingPower:      votingPower,
+		ProposerPriority: proposerPriority,
+		Height:           height,
+	}
+}
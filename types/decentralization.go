@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecentralizationMetrics contains the metrics describing how the voting power
+// is distributed among the active validators at a given height
+type DecentralizationMetrics struct {
+	// NakamotoCoefficient33 is the minimum number of validators controlling more than 1/3 of the voting power
+	NakamotoCoefficient33 int64
+
+	// NakamotoCoefficient67 is the minimum number of validators controlling more than 2/3 of the voting power
+	NakamotoCoefficient67 int64
+
+	GiniCoefficient  sdk.Dec
+	Top10Share       sdk.Dec
+	Top20Share       sdk.Dec
+	ActiveSetSize    int64
+	TotalVotingPower sdk.Int
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewDecentralizationMetrics allows to build a new DecentralizationMetrics instance
+func NewDecentralizationMetrics(
+	nakamoto33, nakamoto67 int64, gini, top10Share, top20Share sdk.Dec, activeSetSize int64,
+	totalVotingPower sdk.Int, height int64, timestamp time.Time,
+) DecentralizationMetrics {
+	return DecentralizationMetrics{
+		NakamotoCoefficient33: nakamoto33,
+		NakamotoCoefficient67: nakamoto67,
+		GiniCoefficient:       gini,
+		Top10Share:            top10Share,
+		Top20Share:            top20Share,
+		ActiveSetSize:         activeSetSize,
+		TotalVotingPower:      totalVotingPower,
+		Height:                height,
+		Timestamp:             timestamp,
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Delegation represents the tokens delegated by an account to a validator at a given height
+type Delegation struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           sdk.Coin
+	Shares           string
+	Height           int64
+}
+
+// NewDelegation allows to build a new Delegation instance
+func NewDelegation(delegator, validator string, amount sdk.Coin, shares string, height int64) Delegation {
+	return Delegation{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+		Shares:           shares,
+		Height:           height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// UnbondingDelegation represents a single unbonding entry of an account from a validator at a given height
+type UnbondingDelegation struct {
+	DelegatorAddress    string
+	ValidatorAddress    string
+	InitialBalance      string
+	Balance             string
+	CreationHeight      int64
+	CompletionTimestamp time.Time
+	Height              int64
+}
+
+// NewUnbondingDelegation allows to build a new UnbondingDelegation instance
+func NewUnbondingDelegation(
+	delegator, validator, initialBalance, balance string, creationHeight int64, completionTimestamp time.Time, height int64,
+) UnbondingDelegation {
+	return UnbondingDelegation{
+		DelegatorAddress:    delegator,
+		ValidatorAddress:    validator,
+		InitialBalance:      initialBalance,
+		Balance:             balance,
+		CreationHeight:      creationHeight,
+		CompletionTimestamp: completionTimestamp,
+		Height:              height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// Redelegation represents a single redelegation entry of an account between two validators at a given height
+type Redelegation struct {
+	DelegatorAddress    string
+	SrcValidatorAddress string
+	DstValidatorAddress string
+	InitialBalance      string
+	Balance             string
+	CreationHeight      int64
+	CompletionTime      time.Time
+	Height              int64
+}
+
+// NewRedelegation allows to build a new Redelegation instance
+func NewRedelegation(
+	delegator, srcValidator, dstValidator, initialBalance, balance string,
+	creationHeight int64, completionTime time.Time, height int64,
+) Redelegation {
+	return Redelegation{
+		DelegatorAddress:    delegator,
+		SrcValidatorAddress: srcValidator,
+		DstValidatorAddress: dstValidator,
+		InitialBalance:      initialBalance,
+		Balance:             balance,
+		CreationHeight:      creationHeight,
+		CompletionTime:      completionTime,
+		Height:              height,
+	}
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // IBCTransfer represents the x/ibc transfer parameters
 type IBCTransfer struct {
 	ReceiveEnabled bool `json:"receive_enabled" yaml:"receive_enabled"`
@@ -19,3 +26,209 @@ func NewIBCTransferParams(params IBCTransfer, height int64) *IBCTransferParams {
 		Height: height,
 	}
 }
+
+// ----------------------------------------------------------------------------------------------------------
+
+const (
+	IBCPacketStatusSent         = "sent"
+	IBCPacketStatusReceived     = "received"
+	IBCPacketStatusAcknowledged = "acknowledged"
+	IBCPacketStatusTimeout      = "timeout"
+
+	IBCTransferDirectionOutgoing = "outgoing"
+	IBCTransferDirectionIncoming = "incoming"
+)
+
+// IBCPacket represents a single step of the lifecycle of an IBC packet, which is identified by its
+// source port, source channel, sequence, destination port and destination channel
+type IBCPacket struct {
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	Sequence           uint64
+	Data               string
+	TimeoutHeight      string
+	TimeoutTimestamp   uint64
+
+	// Status represents the lifecycle step that has been reached by the packet
+	Status string
+
+	// TxHash is the hash of the transaction that has made the packet reach the status
+	TxHash string
+
+	// RelayerAddress is the signer of the relay message, if the packet has been relayed
+	RelayerAddress string
+
+	Height int64
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// IBCTokenTransfer represents a fungible token transfer sent or received through an IBC channel
+type IBCTokenTransfer struct {
+	SourcePort    string
+	SourceChannel string
+	Sequence      uint64
+
+	// Direction tells whether the transfer has been sent from or received by the chain
+	Direction string
+
+	// Channel is the channel of the chain through which the transfer has been sent or received
+	Channel string
+
+	Denom     string
+	Amount    sdk.Int
+	Sender    string
+	Receiver  string
+	TxHash    string
+	Height    int64
+	Timestamp time.Time
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// IBCHeightResponse contains the data of an IBC height returned by the REST endpoints
+type IBCHeightResponse struct {
+	RevisionNumber uint64 `json:"revision_number,string"`
+	RevisionHeight uint64 `json:"revision_height,string"`
+}
+
+// IsZero tells whether the height is not set
+func (h IBCHeightResponse) IsZero() bool {
+	return h.RevisionNumber == 0 && h.RevisionHeight == 0
+}
+
+// String implements fmt.Stringer
+func (h IBCHeightResponse) String() string {
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
+
+// IdentifiedClientStateResponse contains the data of an IBC light client returned by the REST endpoints.
+// Only the fields of the Tendermint light clients are supported.
+type IdentifiedClientStateResponse struct {
+	ClientID    string `json:"client_id"`
+	ClientState struct {
+		Type           string            `json:"@type"`
+		ChainID        string            `json:"chain_id"`
+		TrustingPeriod string            `json:"trusting_period"`
+		FrozenHeight   IBCHeightResponse `json:"frozen_height"`
+		LatestHeight   IBCHeightResponse `json:"latest_height"`
+	} `json:"client_state"`
+}
+
+// QueryClientStatesResponse contains a page of the IBC light clients
+type QueryClientStatesResponse struct {
+	ClientStates []IdentifiedClientStateResponse `json:"client_states"`
+	Pagination   PageResponse                    `json:"pagination"`
+}
+
+// QueryConsensusStateResponse contains the consensus state of an IBC light client at a given height
+type QueryConsensusStateResponse struct {
+	ConsensusState struct {
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"consensus_state"`
+}
+
+// IdentifiedConnectionResponse contains the data of an IBC connection returned by the REST endpoints
+type IdentifiedConnectionResponse struct {
+	ID           string `json:"id"`
+	ClientID     string `json:"client_id"`
+	State        string `json:"state"`
+	Counterparty struct {
+		ClientID     string `json:"client_id"`
+		ConnectionID string `json:"connection_id"`
+	} `json:"counterparty"`
+	DelayPeriod uint64 `json:"delay_period,string"`
+}
+
+// QueryConnectionsResponse contains a page of the IBC connections
+type QueryConnectionsResponse struct {
+	Connections []IdentifiedConnectionResponse `json:"connections"`
+	Pagination  PageResponse                   `json:"pagination"`
+}
+
+// IdentifiedChannelResponse contains the data of an IBC channel returned by the REST endpoints
+type IdentifiedChannelResponse struct {
+	PortID       string `json:"port_id"`
+	ChannelID    string `json:"channel_id"`
+	State        string `json:"state"`
+	Ordering     string `json:"ordering"`
+	Counterparty struct {
+		PortID    string `json:"port_id"`
+		ChannelID string `json:"channel_id"`
+	} `json:"counterparty"`
+	ConnectionHops []string `json:"connection_hops"`
+	Version        string   `json:"version"`
+}
+
+// QueryChannelsResponse contains a page of the IBC channels
+type QueryChannelsResponse struct {
+	Channels   []IdentifiedChannelResponse `json:"channels"`
+	Pagination PageResponse                `json:"pagination"`
+}
+
+// DenomTraceResponse contains the data of an IBC denom trace returned by the REST endpoints
+type DenomTraceResponse struct {
+	Path      string `json:"path"`
+	BaseDenom string `json:"base_denom"`
+}
+
+// QueryDenomTracesResponse contains a page of the IBC denom traces
+type QueryDenomTracesResponse struct {
+	DenomTraces []DenomTraceResponse `json:"denom_traces"`
+	Pagination  PageResponse         `json:"pagination"`
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+const (
+	IBCClientStatusActive  = "active"
+	IBCClientStatusExpired = "expired"
+	IBCClientStatusFrozen  = "frozen"
+	IBCClientStatusUnknown = "unknown"
+)
+
+// IBCClient represents the state of an IBC light client at a given height
+type IBCClient struct {
+	ClientID        string
+	ChainID         string
+	TrustingPeriod  time.Duration
+	LatestHeight    string
+	LatestTimestamp time.Time
+	FrozenHeight    string
+	Status          string
+	Height          int64
+}
+
+// IBCConnection represents the state of an IBC connection at a given height
+type IBCConnection struct {
+	ConnectionID             string
+	ClientID                 string
+	State                    string
+	CounterpartyClientID     string
+	CounterpartyConnectionID string
+	DelayPeriod              uint64
+	Height                   int64
+}
+
+// IBCChannel represents the state of an IBC channel at a given height
+type IBCChannel struct {
+	PortID                string
+	ChannelID             string
+	State                 string
+	Ordering              string
+	CounterpartyPortID    string
+	CounterpartyChannelID string
+	ConnectionHops        []string
+	Version               string
+	Height                int64
+}
+
+// IBCDenomTrace represents the origin of an IBC voucher denom
+type IBCDenomTrace struct {
+	Denom     string
+	Path      string
+	BaseDenom string
+	Height    int64
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,14 +18,64 @@ type InflationResponse struct {
 type StakingPool struct {
 	BondedTokens    sdk.Int
 	NotBondedTokens sdk.Int
+	BondedRatio     sdk.Dec
 	Height          int64
 }
 
 // NewStakingPool allows to build a new StakingPool instance
-func NewStakingPool(bondedTokens sdk.Int, notBondedTokens sdk.Int, height int64) *StakingPool {
+func NewStakingPool(bondedTokens sdk.Int, notBondedTokens sdk.Int, bondedRatio sdk.Dec, height int64) *StakingPool {
 	return &StakingPool{
 		BondedTokens:    bondedTokens,
 		NotBondedTokens: notBondedTokens,
+		BondedRatio:     bondedRatio,
 		Height:          height,
 	}
 }
+
+// Validate checks that the staking pool values are consistent with the given total supply
+func (p *StakingPool) Validate(totalSupply sdk.Int) error {
+	if p.BondedTokens.IsNegative() || p.NotBondedTokens.IsNegative() {
+		return fmt.Errorf("negative staking pool tokens: bonded %s, not bonded %s", p.BondedTokens, p.NotBondedTokens)
+	}
+
+	if p.BondedTokens.Add(p.NotBondedTokens).GT(totalSupply) {
+		return fmt.Errorf("staking pool tokens %s exceed the total supply %s",
+			p.BondedTokens.Add(p.NotBondedTokens), totalSupply)
+	}
+
+	if p.BondedRatio.IsNegative() || p.BondedRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid bonded ratio: %s", p.BondedRatio)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// StakingAPR contains the annual percentage rate earned by delegators at a given height,
+// along with the values it has been computed from
+type StakingAPR struct {
+	Inflation         sdk.Dec
+	TotalSupply       sdk.Int
+	BondedTokens      sdk.Int
+	AverageCommission sdk.Dec
+	APR               sdk.Dec
+	Height            int64
+	Timestamp         time.Time
+}
+
+// NewStakingAPR allows to build a new StakingAPR instance
+func NewStakingAPR(
+	inflation sdk.Dec, totalSupply, bondedTokens sdk.Int, averageCommission, apr sdk.Dec,
+	height int64, timestamp time.Time,
+) StakingAPR {
+	return StakingAPR{
+		Inflation:         inflation,
+		TotalSupply:       totalSupply,
+		BondedTokens:      bondedTokens,
+		AverageCommission: averageCommission,
+		APR:               apr,
+		Height:            height,
+		Timestamp:         timestamp,
+	}
+}
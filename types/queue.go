@@ -6,3 +6,22 @@ type HeightQueue chan int64
 func NewQueue(size int) HeightQueue {
 	return make(chan int64, size)
 }
+
+// HeightRange represents a range of consecutive block heights, both ends included
+type HeightRange struct {
+	Start int64
+	End   int64
+}
+
+// NewHeightRange allows to build a new HeightRange instance
+func NewHeightRange(start, end int64) HeightRange {
+	return HeightRange{
+		Start: start,
+		End:   end,
+	}
+}
+
+// Len returns the number of heights contained inside the range
+func (r HeightRange) Len() int64 {
+	return r.End - r.Start + 1
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RichListEntry represents one of the top holders of a denom
+type RichListEntry struct {
+	Denom   string
+	Rank    int64
+	Address string
+	Amount  sdk.Int
+
+	// Share is the amount held by the account over the supply of the denom
+	Share sdk.Dec
+
+	// Label is the label associated with the address inside the configuration, if any
+	Label string
+}
+
+// NewRichListEntry allows to build a new RichListEntry instance
+func NewRichListEntry(denom string, rank int64, address string, amount sdk.Int, share sdk.Dec, label string) RichListEntry {
+	return RichListEntry{
+		Denom:   denom,
+		Rank:    rank,
+		Address: address,
+		Amount:  amount,
+		Share:   share,
+		Label:   label,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// HoldersBucket contains the number of holders of a denom having a balance inside a given range
+type HoldersBucket struct {
+	Denom     string
+	MinAmount sdk.Int
+
+	// MaxAmount is the exclusive upper bound of the bucket, or nil if the bucket is unbounded
+	MaxAmount *sdk.Int
+
+	HoldersCount int64
+	TotalAmount  sdk.Int
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// HoldersDistribution contains the share of the supply of a denom held by its top holders
+type HoldersDistribution struct {
+	Denom        string
+	HoldersCount int64
+	TotalAmount  sdk.Int
+	Supply       sdk.Int
+	Top1Share    sdk.Dec
+	Top10Share   sdk.Dec
+	Top100Share  sdk.Dec
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// RichList contains the holders analytics of all the denoms computed at a given height
+type RichList struct {
+	Entries       []RichListEntry
+	Buckets       []HoldersBucket
+	Distributions []HoldersDistribution
+	Height        int64
+}
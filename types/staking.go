@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DoubleSignEvidence represent a double sign evidence on each tendermint block
 type DoubleSignEvidence struct {
@@ -54,6 +58,68 @@ func NewDoubleSignVote(
 
 // ----------------------------------------------------------------------------------------------------------
 
+// LightClientAttackEvidence represents a light client attack evidence included inside a tendermint block
+type LightClientAttackEvidence struct {
+	Hash                   string
+	ConflictingBlockHash   string
+	ConflictingBlockHeight int64
+	CommonHeight           int64
+	ByzantineValidators    []string
+	TotalVotingPower       int64
+	Timestamp              time.Time
+	Height                 int64
+}
+
+// NewLightClientAttackEvidence allows to build a new LightClientAttackEvidence instance
+func NewLightClientAttackEvidence(
+	hash, conflictingBlockHash string, conflictingBlockHeight, commonHeight int64, byzantineValidators []string,
+	totalVotingPower int64, timestamp time.Time, height int64,
+) LightClientAttackEvidence {
+	return LightClientAttackEvidence{
+		Hash:                   hash,
+		ConflictingBlockHash:   conflictingBlockHash,
+		ConflictingBlockHeight: conflictingBlockHeight,
+		CommonHeight:           commonHeight,
+		ByzantineValidators:    byzantineValidators,
+		TotalVotingPower:       totalVotingPower,
+		Timestamp:              timestamp,
+		Height:                 height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// SlashingReasonJailed is the reason used for the validators that have been jailed without being slashed
+const SlashingReasonJailed = "jailed"
+
+// ValidatorSlashing represents a slashing or jailing of a validator that happened at a given height
+type ValidatorSlashing struct {
+	ValidatorAddress string
+	InfractionHeight int64
+	Power            string
+	Reason           string
+	Jailed           bool
+	BurnedCoins      string
+	Height           int64
+}
+
+// NewValidatorSlashing allows to build a new ValidatorSlashing instance
+func NewValidatorSlashing(
+	validatorAddress string, infractionHeight int64, power, reason string, jailed bool, burnedCoins string, height int64,
+) ValidatorSlashing {
+	return ValidatorSlashing{
+		ValidatorAddress: validatorAddress,
+		InfractionHeight: infractionHeight,
+		Power:            power,
+		Reason:           reason,
+		Jailed:           jailed,
+		BurnedCoins:      burnedCoins,
+		Height:           height,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
 // Validator contains the data of a single validator
 type Validator struct {
 	ConsensusAddr       string
@@ -129,6 +195,29 @@ func NewValidatorDescription(
 
 // ----------------------------------------------------------------------------------------------------------
 
+// ValidatorAvatar represents the avatar resolved for a validator identity at a given time
+type ValidatorAvatar struct {
+	Identity  string
+	AvatarURL string
+	UpdatedAt time.Time
+}
+
+// NewValidatorAvatar allows to build a new ValidatorAvatar instance
+func NewValidatorAvatar(identity, avatarURL string, updatedAt time.Time) ValidatorAvatar {
+	return ValidatorAvatar{
+		Identity:  identity,
+		AvatarURL: avatarURL,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// IsExpired tells whether the avatar should be resolved again at the given time, based on the given TTL
+func (a ValidatorAvatar) IsExpired(ttl time.Duration, now time.Time) bool {
+	return a.UpdatedAt.Add(ttl).Before(now)
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
 // ValidatorsList represents validators list from a file
 type ValidatorsList struct {
 	Validators []ValidatorList `yaml:"validators"`
@@ -194,13 +283,128 @@ func NewValidatorStatus(valConsAddr, selfDelegateAddress, inActiveSet, jailed, t
 	}
 }
 
-// DelegationResponses represents the total delegation value
-// of each address
-type DelegationResponses struct {
+// DelegationResponse represents a single delegation returned by the REST endpoints
+type DelegationResponse struct {
+	Delegation struct {
+		DelegatorAddress string `json:"delegator_address"`
+		ValidatorAddress string `json:"validator_address"`
+		Shares           string `json:"shares"`
+	} `json:"delegation"`
 	Balance sdk.Coin `json:"balance"`
 }
 
-// QueryAllBalancesResponse contains the account total delegation value
-type QueryTotalDelegationsResponse struct {
-	DelegationResponses []DelegationResponses `json:"delegation_responses"`
+// QueryDelegationsResponse contains the delegations of an account or of a validator
+type QueryDelegationsResponse struct {
+	DelegationResponses []DelegationResponse `json:"delegation_responses"`
+	Pagination          PageResponse         `json:"pagination"`
+}
+
+// UnbondingDelegationEntryResponse represents a single unbonding entry returned by the REST endpoints
+type UnbondingDelegationEntryResponse struct {
+	CreationHeight int64     `json:"creation_height,string"`
+	CompletionTime time.Time `json:"completion_time"`
+	InitialBalance string    `json:"initial_balance"`
+	Balance        string    `json:"balance"`
+}
+
+// UnbondingDelegationResponse represents all the unbonding entries of a delegator from a validator
+type UnbondingDelegationResponse struct {
+	DelegatorAddress string                             `json:"delegator_address"`
+	ValidatorAddress string                             `json:"validator_address"`
+	Entries          []UnbondingDelegationEntryResponse `json:"entries"`
+}
+
+// QueryUnbondingDelegationsResponse contains the unbonding delegations of an account
+type QueryUnbondingDelegationsResponse struct {
+	UnbondingResponses []UnbondingDelegationResponse `json:"unbonding_responses"`
+	Pagination         PageResponse                  `json:"pagination"`
+}
+
+// RedelegationEntryResponse represents a single redelegation entry returned by the REST endpoints
+type RedelegationEntryResponse struct {
+	RedelegationEntry struct {
+		CreationHeight int64     `json:"creation_height,string"`
+		CompletionTime time.Time `json:"completion_time"`
+		InitialBalance string    `json:"initial_balance"`
+		SharesDst      string    `json:"shares_dst"`
+	} `json:"redelegation_entry"`
+	Balance string `json:"balance"`
+}
+
+// RedelegationResponse represents all the redelegation entries of a delegator between two validators
+type RedelegationResponse struct {
+	Redelegation struct {
+		DelegatorAddress    string `json:"delegator_address"`
+		ValidatorSrcAddress string `json:"validator_src_address"`
+		ValidatorDstAddress string `json:"validator_dst_address"`
+	} `json:"redelegation"`
+	Entries []RedelegationEntryResponse `json:"entries"`
+}
+
+// QueryRedelegationsResponse contains the redelegations of an account
+type QueryRedelegationsResponse struct {
+	RedelegationResponses []RedelegationResponse `json:"redelegation_responses"`
+	Pagination            PageResponse           `json:"pagination"`
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// ValidatorEventType represents the kind of change that happened to a validator
+type ValidatorEventType string
+
+const (
+	ValidatorEventCommissionChanged ValidatorEventType = "commission_changed"
+	ValidatorEventJailed            ValidatorEventType = "jailed"
+	ValidatorEventUnjailed          ValidatorEventType = "unjailed"
+	ValidatorEventTombstoned        ValidatorEventType = "tombstoned"
+	ValidatorEventEnteredActiveSet  ValidatorEventType = "entered_active_set"
+	ValidatorEventLeftActiveSet     ValidatorEventType = "left_active_set"
+)
+
+// ValidatorEvent represents a change that happened to a validator at a given height
+type ValidatorEvent struct {
+	SelfDelegateAddress string
+	ValidatorAddress    string
+	Type                ValidatorEventType
+	OldValue            string
+	NewValue            string
+	Height              int64
+	Timestamp           time.Time
+}
+
+// NewValidatorEvent allows to build a new ValidatorEvent instance
+func NewValidatorEvent(
+	selfDelegateAddress, validatorAddress string, eventType ValidatorEventType, oldValue, newValue string,
+	height int64, timestamp time.Time,
+) ValidatorEvent {
+	return ValidatorEvent{
+		SelfDelegateAddress: selfDelegateAddress,
+		ValidatorAddress:    validatorAddress,
+		Type:                eventType,
+		OldValue:            oldValue,
+		NewValue:            newValue,
+		Height:              height,
+		Timestamp:           timestamp,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
+// ValidatorsInfo contains the current data of the validators, along with the values that changed with respect to the
+// stored ones and the resulting events, so that the current values and their history can be stored together
+type ValidatorsInfo struct {
+	Validators   []Validator
+	Commissions  []ValidatorCommission
+	Descriptions []ValidatorDescription
+	Statuses     []ValidatorStatus
+	VotingPowers []ValidatorVotingPower
+
+	ChangedCommissions  []ValidatorCommission
+	ChangedDescriptions []ValidatorDescription
+	ChangedStatuses     []ValidatorStatus
+	ChangedVotingPowers []ValidatorVotingPower
+	Events              []ValidatorEvent
+
+	// Timestamp represents the time of the block at which the validators data has been read
+	Timestamp time.Time
 }
@@ -0,0 +1,26 @@
+package types
+
+import "time"
+
+// StatsGranularity represents the length of the periods over which the chain statistics are aggregated
+type StatsGranularity string
+
+const (
+	StatsGranularityHour StatsGranularity = "hour"
+	StatsGranularityDay  StatsGranularity = "day"
+)
+
+// Duration returns the length of a single period having this granularity
+func (g StatsGranularity) Duration() time.Duration {
+	switch g {
+	case StatsGranularityHour:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// Truncate returns the start of the period having this granularity that contains the given time
+func (g StatsGranularity) Truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(g.Duration())
+}
@@ -18,6 +18,17 @@ func NewToken(name string, units []TokenUnit) Token {
 	}
 }
 
+// GetDisplayUnit returns the unit of the token having the highest exponent, or nil if the token has no unit
+func (t Token) GetDisplayUnit() *TokenUnit {
+	var display *TokenUnit
+	for i, unit := range t.Units {
+		if display == nil || unit.Exponent > display.Exponent {
+			display = &t.Units[i]
+		}
+	}
+	return display
+}
+
 // ----------------------------------------------------------------------------------------------------------
 
 // TokenPrice represents the price at a given moment in time of a token unit
@@ -29,9 +29,60 @@ type TxMsg struct {
 }
 
 type TxMsgValue struct {
-	Amount           sdk.Coin `json:"amount" yaml:"amount"`
-	DelegatorAddress string   `json:"delegator_address" yaml:"delegator_address"`
-	ValidatorAddress string   `json:"validator_address" yaml:"validator_address"`
+	Amount              sdk.Coin `json:"amount" yaml:"amount"`
+	DelegatorAddress    string   `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress    string   `json:"validator_address" yaml:"validator_address"`
+	ValidatorSrcAddress string   `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress string   `json:"validator_dst_address" yaml:"validator_dst_address"`
+	FromAddress         string   `json:"from_address" yaml:"from_address"`
+	ToAddress           string   `json:"to_address" yaml:"to_address"`
+	Sender              string   `json:"sender" yaml:"sender"`
+	DstAddress          string   `json:"dst_address" yaml:"dst_address"`
+	Signer              string   `json:"signer" yaml:"signer"`
+}
+
+// GetSigner returns the address of the account that signed the message. This is the explicit signer
+// of the IBC messages, the delegator of the staking messages or the sender of the transfers and IBC transfers.
+// NOTE. Since the transactions signatures do not contain the signers public keys, this is an approximation
+// that relies on the messages fields: messages whose signer is not one of them are not attributed to anyone
+func (v TxMsgValue) GetSigner() string {
+	switch {
+	case v.Signer != "":
+		return v.Signer
+	case v.DelegatorAddress != "":
+		return v.DelegatorAddress
+	case v.FromAddress != "":
+		return v.FromAddress
+	default:
+		return v.Sender
+	}
+}
+
+// GetAccountAddresses returns the non empty chain account addresses contained inside the message value.
+// Validator operator addresses, the Bitcoin destination address of withdrawals and the counterparty chain
+// receiver of IBC transfers are not included
+func (v TxMsgValue) GetAccountAddresses() []string {
+	var addresses []string
+	for _, address := range []string{v.Signer, v.DelegatorAddress, v.FromAddress, v.ToAddress, v.Sender} {
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// GetSigners returns the distinct addresses of the accounts that signed the transaction messages
+func (tx TxResponse) GetSigners() []string {
+	var signers []string
+	seen := map[string]bool{}
+	for _, msg := range tx.Msg {
+		signer := msg.Value.GetSigner()
+		if signer != "" && !seen[signer] {
+			seen[signer] = true
+			signers = append(signers, signer)
+		}
+	}
+	return signers
 }
 
 // NewTxResponse allows to build a new TxResponse instance
@@ -0,0 +1,17 @@
+package types
+
+// ValidatorSignature tells whether a validator signed the commit of a given height
+type ValidatorSignature struct {
+	ValidatorAddress string
+	Height           int64
+	Signed           bool
+}
+
+// NewValidatorSignature allows to build a new ValidatorSignature instance
+func NewValidatorSignature(validatorAddress string, height int64, signed bool) ValidatorSignature {
+	return ValidatorSignature{
+		ValidatorAddress: validatorAddress,
+		Height:           height,
+		Signed:           signed,
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import "time"
+
+// VerificationIssueType represents the kind of inconsistency found between the stored data and the chain
+type VerificationIssueType string
+
+const (
+	VerificationIssueMissingBlock          VerificationIssueType = "missing_block"
+	VerificationIssueHashMismatch          VerificationIssueType = "hash_mismatch"
+	VerificationIssueNumTxsMismatch        VerificationIssueType = "num_txs_mismatch"
+	VerificationIssueTransactionsMismatch  VerificationIssueType = "transactions_mismatch"
+	VerificationIssueCommitSignersMismatch VerificationIssueType = "commit_signers_mismatch"
+)
+
+// VerificationIssue contains the data of a single inconsistency found between the stored data and the chain
+type VerificationIssue struct {
+	Height     int64
+	Type       VerificationIssueType
+	Expected   string
+	Actual     string
+	Reenqueued bool
+	Timestamp  time.Time
+}
+
+// NewVerificationIssue allows to build a new VerificationIssue instance
+func NewVerificationIssue(
+	height int64, issueType VerificationIssueType, expected, actual string, timestamp time.Time,
+) VerificationIssue {
+	return VerificationIssue{
+		Height:    height,
+		Type:      issueType,
+		Expected:  expected,
+		Actual:    actual,
+		Timestamp: timestamp,
+	}
+}
+
+// ReprocessHeight returns the height that should be parsed again in order to fix this issue.
+// Commit signatures of a block are contained inside the following one, so commit issues require
+// the next height to be parsed again.
+func (i VerificationIssue) ReprocessHeight() int64 {
+	if i.Type == VerificationIssueCommitSignersMismatch {
+		return i.Height + 1
+	}
+	return i.Height
+}